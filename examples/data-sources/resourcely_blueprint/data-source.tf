@@ -1,3 +1,7 @@
 data "resourcely_blueprint" "example" {
   series_id = "00000000-00000000-00000000-00000000"
 }
+
+data "resourcely_blueprint" "by_name" {
+  name = "S3 Bucket"
+}
@@ -0,0 +1,9 @@
+data "resourcely_blueprints" "aws_storage" {
+  cloud_provider = "PROVIDER_AMAZON"
+  categories     = ["BLUEPRINT_BLOB_STORAGE"]
+  is_published   = true
+}
+
+output "aws_storage_blueprint_series_ids" {
+  value = { for blueprint in data.resourcely_blueprints.aws_storage.blueprints : blueprint.name => blueprint.series_id }
+}
@@ -1,3 +1,7 @@
 data "resourcely_context_question" "example" {
   series_id = "00000000-00000000-00000000-00000000"
 }
+
+data "resourcely_context_question" "by_label" {
+  label = "data_classification"
+}
@@ -0,0 +1,7 @@
+data "resourcely_context_questions" "storage" {
+  blueprint_categories = ["BLUEPRINT_BLOB_STORAGE"]
+}
+
+output "storage_context_question_labels" {
+  value = [for context_question in data.resourcely_context_questions.storage.context_questions : context_question.label]
+}
@@ -1,3 +1,7 @@
 data "resourcely_global_value" "example" {
   series_id = "00000000-00000000-00000000-00000000"
 }
+
+data "resourcely_global_value" "by_key" {
+  key = "allowed_regions"
+}
@@ -0,0 +1,7 @@
+data "resourcely_global_values" "current" {
+  is_deprecated = false
+}
+
+output "global_value_keys" {
+  value = [for global_value in data.resourcely_global_values.current.global_values : global_value.key]
+}
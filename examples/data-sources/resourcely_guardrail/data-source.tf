@@ -1,3 +1,7 @@
 data "resourcely_guardrail" "example" {
   series_id = "00000000-00000000-00000000-00000000"
 }
+
+data "resourcely_guardrail" "by_name" {
+  name = "Require S3 bucket encryption"
+}
@@ -0,0 +1,8 @@
+data "resourcely_guardrails" "active" {
+  categories = ["GUARDRAIL_BEST_PRACTICES"]
+  states     = ["GUARDRAIL_STATE_ACTIVE"]
+}
+
+output "active_guardrail_series_ids" {
+  value = [for guardrail in data.resourcely_guardrails.active.guardrails : guardrail.series_id]
+}
@@ -0,0 +1,25 @@
+data "resourcely_tenant" "current" {}
+
+resource "resourcely_global_value" "environments" {
+  key  = "environments"
+  name = "Environments"
+  type = "PRESET_VALUE_TEXT"
+  options = [
+    {
+      key   = "dev"
+      label = "Development"
+      value = jsonencode("dev")
+    },
+  ]
+
+  lifecycle {
+    precondition {
+      condition     = data.resourcely_tenant.current.tenant == "mycompany-staging"
+      error_message = "This module only manages the mycompany-staging tenant, not ${data.resourcely_tenant.current.tenant}."
+    }
+  }
+}
+
+output "resourcely_token_expires_at" {
+  value = data.resourcely_tenant.current.expires_at
+}
@@ -0,0 +1,3 @@
+provider "resourcely" {
+  read_only = true
+}
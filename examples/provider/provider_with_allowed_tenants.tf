@@ -1,3 +1,4 @@
 provider "resourcely" {
-  allowed_tenants = ["MyCompany"]
+  allowed_tenants = ["MyCompany", "mycompany-*"]
+  denied_tenants  = ["/-prod(uction)?$/"]
 }
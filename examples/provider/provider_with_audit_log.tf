@@ -0,0 +1,3 @@
+provider "resourcely" {
+  audit_log_path = "${path.root}/resourcely-audit.jsonl"
+}
@@ -0,0 +1,3 @@
+provider "resourcely" {
+  credential_process = "vault kv get -field=token secret/resourcely"
+}
@@ -0,0 +1,11 @@
+# ~/.resourcely/credentials
+#
+#   [default]
+#   auth_token_file = /run/secrets/resourcely-token
+#
+#   [staging]
+#   credential_process = vault kv get -field=token secret/resourcely-staging
+
+provider "resourcely" {
+  profile = "staging"
+}
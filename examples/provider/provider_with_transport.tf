@@ -0,0 +1,10 @@
+provider "resourcely" {
+  host            = "https://resourcely.internal.example.com"
+  request_timeout = "30s"
+  max_retries     = 2
+  proxy_url       = "http://proxy.example.com:3128"
+  ca_bundle_file  = "/etc/ssl/certs/corporate-ca.pem"
+
+  client_cert_file = "/etc/resourcely/client.pem"
+  client_key_file  = "/etc/resourcely/client-key.pem"
+}
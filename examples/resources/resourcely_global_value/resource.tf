@@ -3,6 +3,9 @@ resource "resourcely_global_value" "departments" {
   key         = "departments"
   description = "All departments within MyCompany"
 
+  # Deprecate the global value on destroy, since it cannot be deleted
+  on_destroy = "deprecate"
+
   type = "PRESET_VALUE_TEXT"
   options = [
     {
@@ -0,0 +1,242 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// AuditLog appends a JSON line to a local file for every request that
+// could change Resourcely, so that each apply leaves a record of
+// exactly what it changed.
+type AuditLog struct {
+	Path string
+
+	mu sync.Mutex
+}
+
+// AuditRecord is a line of the audit log.
+type AuditRecord struct {
+	Time          time.Time       `json:"time"`
+	Method        string          `json:"method"`
+	Path          string          `json:"path"`
+	EntityType    string          `json:"entity_type,omitempty"`
+	SeriesId      string          `json:"series_id,omitempty"`
+	VersionBefore *int64          `json:"version_before"`
+	VersionAfter  *int64          `json:"version_after"`
+	Status        int             `json:"status,omitempty"`
+	RequestId     string          `json:"request_id,omitempty"`
+	RequestBody   json.RawMessage `json:"request_body,omitempty"`
+	Error         string          `json:"error,omitempty"`
+}
+
+// Redacted replaces the values of request body fields whose names
+// suggest a secret.
+const Redacted = "REDACTED"
+
+var secretFieldNames = []string{"token", "secret", "password", "credential", "private_key"}
+
+// Entity types by the path segment after BasePath.
+var entityTypes = map[string]string{
+	"blueprints":        "blueprint",
+	"guardrails":        "guardrail",
+	"context-questions": "context_question",
+	"presets":           "global_value",
+}
+
+// NewAuditLog returns an audit log that appends to the file at path,
+// creating it if it does not exist. It fails if the file cannot be
+// opened for writing, so a bad path is reported before any change is
+// made.
+func NewAuditLog(path string) (*AuditLog, error) {
+	f, err := openAuditLog(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := f.Close(); err != nil {
+		return nil, err
+	}
+	return &AuditLog{Path: path}, nil
+}
+
+func openAuditLog(path string) (*os.File, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("opening audit log: %w", err)
+	}
+	return f, nil
+}
+
+// Write appends the record as a single line. The file is opened for
+// each record, so that concurrent Terraform runs sharing a log do not
+// overwrite each other's records.
+func (l *AuditLog) Write(record *AuditRecord) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	f, err := openAuditLog(l.Path)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(line); err != nil {
+		f.Close()
+		return fmt.Errorf("writing audit log: %w", err)
+	}
+	return f.Close()
+}
+
+// doAudited executes a request that could change Resourcely and
+// records it in the audit log, whether or not it succeeds.
+func (c *Client) doAudited(ctx context.Context, req *http.Request, v interface{}) (*http.Response, error) {
+	record := newAuditRecord(req, c.BasePath)
+	if record.SeriesId != "" {
+		record.VersionBefore = c.currentVersion(ctx, req)
+	}
+
+	var body bytes.Buffer
+	resp, err := c.do(ctx, req, &body)
+
+	if resp != nil {
+		record.Status = resp.StatusCode
+		record.RequestId = resp.Header.Get(HeaderRequestId)
+	}
+	var errorResponse *ErrorResponse
+	if errors.As(err, &errorResponse) {
+		record.RequestId = errorResponse.Err.RequestId
+	}
+	if err != nil {
+		record.Error = err.Error()
+	} else if req.Method != http.MethodDelete {
+		var entity struct {
+			SeriesId string `json:"series_id"`
+			Version  *int64 `json:"version"`
+		}
+		if json.Unmarshal(body.Bytes(), &entity) == nil {
+			if entity.SeriesId != "" {
+				record.SeriesId = entity.SeriesId
+			}
+			record.VersionAfter = entity.Version
+		}
+	}
+
+	if writeErr := c.AuditLog.Write(record); writeErr != nil {
+		// The change has been made either way, so failing here would
+		// only leave it out of the Terraform state as well.
+		tflog.Warn(ctx, "Could not write to the Resourcely audit log", map[string]any{
+			"audit_log_path": c.AuditLog.Path,
+			"error":          writeErr.Error(),
+		})
+	}
+
+	if err == nil {
+		err = decodeBody(&body, v)
+	}
+	return resp, err
+}
+
+// newAuditRecord describes the request, taking the entity type and
+// series id from its path.
+func newAuditRecord(req *http.Request, basePath string) *AuditRecord {
+	record := &AuditRecord{
+		Time:   time.Now().UTC(),
+		Method: req.Method,
+		Path:   req.URL.Path,
+	}
+
+	record.EntityType, record.SeriesId = entityFromPath(req.URL.Path, basePath)
+
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			data, _ := io.ReadAll(body)
+			record.RequestBody = redactJSON(data)
+		}
+	}
+
+	return record
+}
+
+// entityFromPath returns the type of the entity that a request path
+// refers to, and its series id if the path names one.
+func entityFromPath(urlPath string, basePath string) (entityType string, seriesId string) {
+	segments := strings.Split(strings.TrimPrefix(urlPath, "/"+strings.Trim(basePath, "/")+"/"), "/")
+	entityType = entityTypes[segments[0]]
+	if len(segments) >= 3 && segments[1] == "series" {
+		seriesId = segments[2]
+	}
+	return entityType, seriesId
+}
+
+// currentVersion returns the version of the series the request
+// changes, or nil if it cannot be read.
+func (c *Client) currentVersion(ctx context.Context, req *http.Request) *int64 {
+	getReq, err := c.NewRequest(http.MethodGet, req.URL.String(), nil)
+	if err != nil {
+		return nil
+	}
+
+	var entity struct {
+		Version *int64 `json:"version"`
+	}
+	if _, err := c.do(ctx, getReq, &entity); err != nil {
+		return nil
+	}
+	return entity.Version
+}
+
+// redactJSON returns the JSON document with the values of secret
+// fields replaced by Redacted, or nil if data is not JSON.
+func redactJSON(data []byte) json.RawMessage {
+	var document interface{}
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil
+	}
+	redacted, err := json.Marshal(redactValue(document))
+	if err != nil {
+		return nil
+	}
+	return redacted
+}
+
+func redactValue(value interface{}) interface{} {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for name, field := range value {
+			if isSecretFieldName(name) {
+				value[name] = Redacted
+			} else {
+				value[name] = redactValue(field)
+			}
+		}
+	case []interface{}:
+		for i, element := range value {
+			value[i] = redactValue(element)
+		}
+	}
+	return value
+}
+
+func isSecretFieldName(name string) bool {
+	name = strings.ToLower(name)
+	for _, secret := range secretFieldNames {
+		if strings.Contains(name, secret) {
+			return true
+		}
+	}
+	return false
+}
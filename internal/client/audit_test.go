@@ -0,0 +1,143 @@
+package client_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/client"
+)
+
+func TestAuditLog_recordsChanges(t *testing.T) {
+	ctx := context.Background()
+	_, c := newFakeClient(t)
+
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	auditLog, err := client.NewAuditLog(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.AuditLog = auditLog
+
+	fields := client.CommonGuardrailFields{
+		Name:     "audited",
+		Provider: "PROVIDER_AMAZON",
+		Category: "GUARDRAIL_BEST_PRACTICES",
+		State:    "GUARDRAIL_STATE_ACTIVE",
+		Content:  "content",
+	}
+	guardrail, _, err := c.Guardrails.CreateGuardrail(ctx, &client.NewGuardrail{CommonGuardrailFields: fields})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fields.Content = "updated content"
+	if _, _, err := c.Guardrails.UpdateGuardrail(ctx, &client.UpdatedGuardrail{SeriesId: guardrail.SeriesId, CommonGuardrailFields: fields}); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := c.Guardrails.GetGuardrailBySeriesId(ctx, guardrail.SeriesId); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Guardrails.DeleteGuardrail(ctx, guardrail.SeriesId); err != nil {
+		t.Fatal(err)
+	}
+
+	records := readAuditLog(t, path)
+	if len(records) != 3 {
+		t.Fatalf("expected a record for each change and none for the read, got %d", len(records))
+	}
+
+	for i, want := range []struct {
+		method        string
+		versionBefore int64
+		versionAfter  int64
+	}{
+		{"POST", 0, 1},
+		{"PUT", 1, 2},
+		{"DELETE", 2, 0},
+	} {
+		record := records[i]
+		if record.Method != want.method || record.EntityType != "guardrail" || record.SeriesId != guardrail.SeriesId {
+			t.Errorf("record %d: unexpected %s %s %s", i, record.Method, record.EntityType, record.SeriesId)
+		}
+		if got := versionOrZero(record.VersionBefore); got != want.versionBefore {
+			t.Errorf("record %d: expected version before %d, got %d", i, want.versionBefore, got)
+		}
+		if got := versionOrZero(record.VersionAfter); got != want.versionAfter {
+			t.Errorf("record %d: expected version after %d, got %d", i, want.versionAfter, got)
+		}
+		if record.RequestId == "" {
+			t.Errorf("record %d: expected a request id", i)
+		}
+	}
+	if !strings.Contains(string(records[1].RequestBody), "updated content") {
+		t.Errorf("expected the request body in the record, got %s", records[1].RequestBody)
+	}
+}
+
+func TestAuditLog_redactsSecrets(t *testing.T) {
+	ctx := context.Background()
+	_, c := newFakeClient(t)
+
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	auditLog, err := client.NewAuditLog(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.AuditLog = auditLog
+
+	body := map[string]interface{}{
+		"name":    "not secret",
+		"options": []interface{}{map[string]interface{}{"api_token": "hunter2"}},
+	}
+	_, _, err = c.Post(ctx, "api/v1/unknown", body, nil)
+	if err == nil {
+		t.Fatal("expected an error for an unknown path")
+	}
+
+	records := readAuditLog(t, path)
+	if len(records) != 1 {
+		t.Fatalf("expected a record for the failed request, got %d", len(records))
+	}
+	if records[0].Error == "" {
+		t.Errorf("expected the error in the record")
+	}
+	if got := string(records[0].RequestBody); strings.Contains(got, "hunter2") || !strings.Contains(got, "not secret") {
+		t.Errorf("expected only the secret to be redacted, got %s", got)
+	}
+}
+
+func TestNewAuditLog_invalidPath(t *testing.T) {
+	if _, err := client.NewAuditLog(filepath.Join(t.TempDir(), "missing", "audit.jsonl")); err == nil {
+		t.Errorf("expected an error for a directory that does not exist")
+	}
+}
+
+func readAuditLog(t *testing.T, path string) []client.AuditRecord {
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	var records []client.AuditRecord
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var record client.AuditRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("parsing audit log line %q: %v", scanner.Text(), err)
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+func versionOrZero(version *int64) int64 {
+	if version == nil {
+		return 0
+	}
+	return *version
+}
@@ -13,7 +13,10 @@ type Blueprint struct {
 	Id       string `json:"id"`
 	SeriesId string `json:"series_id"`
 	Version  int64  `json:"version"`
-	Scope    string `json:"scope"`
+	// UpdatedBy is the user who created this version, if the API
+	// reports it.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	Scope     string `json:"scope"`
 	CommonBlueprintFields
 	Provider    string `json:"provider"`
 	IsPublished bool   `json:"is_published"`
@@ -28,11 +31,15 @@ type NewBlueprint struct {
 
 type UpdatedBlueprint struct {
 	SeriesId string `json:"-"`
+	// Version is the version the update replaces, or zero to
+	// replace whatever version is current.
+	Version int64 `json:"-"`
 	CommonBlueprintFields
 }
 
 type PatchedBlueprint struct {
 	SeriesId    string `json:"-"`
+	Version     int64  `json:"-"`
 	IsPublished bool   `json:"is_published"`
 }
 
@@ -46,6 +53,32 @@ type CommonBlueprintFields struct {
 	ExcludedContextQuestionSeries []string `json:"excluded_context_question_series"`
 }
 
+// BlueprintListOptions filters and sorts the blueprints returned by
+// ListBlueprints. Empty filters are ignored.
+type BlueprintListOptions struct {
+	ListOptions
+
+	// Name matches blueprints with exactly this name.
+	Name     string
+	Provider string
+	// Categories matches blueprints in any of these categories.
+	Categories []string
+	// Labels matches blueprints with any of these labels.
+	Labels      []string
+	IsPublished *bool
+}
+
+func (o *BlueprintListOptions) encode() url.Values {
+	query := url.Values{}
+	o.ListOptions.encode(query)
+	encodeString(query, "name", o.Name)
+	encodeString(query, "provider", o.Provider)
+	encodeAll(query, "category", o.Categories)
+	encodeAll(query, "label", o.Labels)
+	encodeBool(query, "is_published", o.IsPublished)
+	return query
+}
+
 func (s *BlueprintsService) GetBlueprintBySeriesId(ctx context.Context, seriesId string) (*Blueprint, *http.Response, error) {
 	path := fmt.Sprintf("%s/blueprints/series/%s", s.Client.BasePath, seriesId)
 	body, resp, err := s.Client.Get(ctx, path, url.Values{}, new(Blueprint))
@@ -55,6 +88,53 @@ func (s *BlueprintsService) GetBlueprintBySeriesId(ctx context.Context, seriesId
 	return body.(*Blueprint), resp, nil
 }
 
+// GetBlueprintByName returns the blueprint with exactly this name. It
+// returns a nil blueprint if none match, and an error if several do.
+func (s *BlueprintsService) GetBlueprintByName(ctx context.Context, name string) (*Blueprint, *http.Response, error) {
+	// The API's filter may not be exact, so check every match
+	blueprints, resp, err := filterAll(ctx, s.ListBlueprintsPaginator(&BlueprintListOptions{
+		ListOptions: ListOptions{SortField: "series_id"},
+		Name:        name,
+	}), func(b Blueprint) bool { return b.Name == name })
+	if err != nil {
+		return nil, resp, err
+	}
+
+	switch len(blueprints) {
+	case 0:
+		return nil, resp, nil
+	case 1:
+		return &blueprints[0], resp, nil
+	default:
+		return &blueprints[0], resp, fmt.Errorf("Found multiple blueprints with the provided name. Expected just one.")
+	}
+}
+
+// ListBlueprints returns a single page of blueprints. Use
+// ListBlueprintsPaginator to walk every page.
+func (s *BlueprintsService) ListBlueprints(ctx context.Context, opts *BlueprintListOptions) (*QueryResponse[Blueprint], *http.Response, error) {
+	if opts == nil {
+		opts = &BlueprintListOptions{}
+	}
+	path := fmt.Sprintf("%s/blueprints", s.Client.BasePath)
+	body, resp, err := s.Client.Get(ctx, path, opts.encode(), new(QueryResponse[Blueprint]))
+	if err != nil {
+		return nil, resp, err
+	}
+	return body.(*QueryResponse[Blueprint]), resp, nil
+}
+
+func (s *BlueprintsService) ListBlueprintsPaginator(opts *BlueprintListOptions) *Paginator[Blueprint] {
+	filters := BlueprintListOptions{}
+	if opts != nil {
+		filters = *opts
+	}
+	return newPaginator(filters.ListOptions, func(ctx context.Context, listOpts ListOptions) (*QueryResponse[Blueprint], *http.Response, error) {
+		filters.ListOptions = listOpts
+		return s.ListBlueprints(ctx, &filters)
+	})
+}
+
 func (s *BlueprintsService) CreateBlueprint(ctx context.Context, newBlueprint *NewBlueprint) (*Blueprint, *http.Response, error) {
 	path := fmt.Sprintf("%s/blueprints", s.Client.BasePath)
 	body, resp, err := s.Client.Post(ctx, path, newBlueprint, new(Blueprint))
@@ -66,7 +146,7 @@ func (s *BlueprintsService) CreateBlueprint(ctx context.Context, newBlueprint *N
 
 func (s *BlueprintsService) UpdateBlueprint(ctx context.Context, updatedBlueprint *UpdatedBlueprint) (*Blueprint, *http.Response, error) {
 	path := fmt.Sprintf("%s/blueprints/series/%s", s.Client.BasePath, updatedBlueprint.SeriesId)
-	body, resp, err := s.Client.Put(ctx, path, updatedBlueprint, new(Blueprint))
+	body, resp, err := s.Client.PutIfMatch(ctx, path, updatedBlueprint.Version, updatedBlueprint, new(Blueprint))
 	if err != nil {
 		return nil, resp, err
 	}
@@ -75,7 +155,7 @@ func (s *BlueprintsService) UpdateBlueprint(ctx context.Context, updatedBlueprin
 
 func (s *BlueprintsService) PatchBlueprint(ctx context.Context, patchedBlueprint *PatchedBlueprint) (*Blueprint, *http.Response, error) {
 	path := fmt.Sprintf("%s/blueprints/series/%s", s.Client.BasePath, patchedBlueprint.SeriesId)
-	body, resp, err := s.Client.Patch(ctx, path, patchedBlueprint, new(Blueprint))
+	body, resp, err := s.Client.PatchIfMatch(ctx, path, patchedBlueprint.Version, patchedBlueprint, new(Blueprint))
 	if err != nil {
 		return nil, resp, err
 	}
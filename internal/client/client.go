@@ -9,7 +9,8 @@ import (
 	"net/http"
 	"net/url"
 	"runtime"
-	"strings"
+	"strconv"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/hashicorp/go-retryablehttp"
@@ -24,6 +25,9 @@ const (
 	HeaderToken       = "Authorization"
 	HeaderTokenFormat = "Bearer %s"
 
+	HeaderIdempotencyKey = "Idempotency-Key"
+	HeaderIfMatch        = "If-Match"
+
 	MediaTypeJSON = "application/json"
 
 	BasePath = "api/v1"
@@ -47,6 +51,18 @@ type Client struct {
 	UserAgent string
 	AuthToken string
 
+	// TokenExpiresAt is the expiry of AuthToken, or zero if it does not
+	// expire. Requests fail fast once it has passed.
+	TokenExpiresAt time.Time
+
+	// ReadOnly refuses every request except GET, HEAD and OPTIONS with a
+	// *ReadOnlyError, so nothing in Resourcely can be changed.
+	ReadOnly bool
+
+	// AuditLog, if set, records every request that could change
+	// Resourcely.
+	AuditLog *AuditLog
+
 	// Requse a single struct instead of allocating one for each service on the heap
 	common service
 
@@ -79,11 +95,20 @@ func (c *Client) Get(ctx context.Context, path string, queryParameters url.Value
 }
 
 // post makes post requests to the given path with the given fields and stores the response in the given body object.
+//
+// Each call sends a new idempotency key, which every retry of the
+// request reuses, so that the API can recognize a create it has already
+// committed.
 func (c *Client) Post(ctx context.Context, path string, fields interface{}, respBody interface{}) (interface{}, *http.Response, error) {
 	req, err := c.NewRequest("POST", path, fields)
 	if err != nil {
 		return nil, nil, err
 	}
+	key, err := NewIdempotencyKey()
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set(HeaderIdempotencyKey, key)
 	return c.MakeRequest(ctx, req, respBody)
 }
 
@@ -96,6 +121,19 @@ func (c *Client) Put(ctx context.Context, path string, fields interface{}, respB
 	return c.MakeRequest(ctx, req, respBody)
 }
 
+// PutIfMatch is Put with an If-Match header carrying the version of the
+// series that the update replaces. The API rejects the update if the
+// series has moved on to another version since, rather than overwriting
+// a change it was not based on. A zero version sends no header.
+func (c *Client) PutIfMatch(ctx context.Context, path string, version int64, fields interface{}, respBody interface{}) (interface{}, *http.Response, error) {
+	req, err := c.NewRequest("PUT", path, fields)
+	if err != nil {
+		return nil, nil, err
+	}
+	setIfMatch(req, version)
+	return c.MakeRequest(ctx, req, respBody)
+}
+
 // patch makes patch requests to the given path with the given fields and stores the response in the given body object.
 func (c *Client) Patch(ctx context.Context, path string, fields interface{}, respBody interface{}) (interface{}, *http.Response, error) {
 	req, err := c.NewRequest("PATCH", path, fields)
@@ -105,6 +143,22 @@ func (c *Client) Patch(ctx context.Context, path string, fields interface{}, res
 	return c.MakeRequest(ctx, req, respBody)
 }
 
+// PatchIfMatch is Patch with an If-Match header, like PutIfMatch.
+func (c *Client) PatchIfMatch(ctx context.Context, path string, version int64, fields interface{}, respBody interface{}) (interface{}, *http.Response, error) {
+	req, err := c.NewRequest("PATCH", path, fields)
+	if err != nil {
+		return nil, nil, err
+	}
+	setIfMatch(req, version)
+	return c.MakeRequest(ctx, req, respBody)
+}
+
+func setIfMatch(req *http.Request, version int64) {
+	if version != 0 {
+		req.Header.Set(HeaderIfMatch, strconv.FormatInt(version, 10))
+	}
+}
+
 // delete makes delete requests to the given path.
 func (c *Client) Delete(ctx context.Context, path string) (*http.Response, error) {
 	req, err := c.NewRequest("DELETE", path, nil)
@@ -120,11 +174,15 @@ func (c *Client) MakeRequest(ctx context.Context, req *http.Request, body interf
 	return body, resp, err
 }
 
-// NewClient returns a new Resourcely API client.
+// NewClient returns a new Resourcely API client. A nil httpClient uses
+// NewHTTPClient with the default TransportConfig.
 func NewClient(httpClient *retryablehttp.Client, host string, authToken string) (*Client, error) {
 	if httpClient == nil {
-		httpClient = retryablehttp.NewClient()
-		httpClient.HTTPClient = &http.Client{}
+		var err error
+		httpClient, err = NewHTTPClient(TransportConfig{})
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	baseURL, err := url.Parse(host)
@@ -136,6 +194,9 @@ func NewClient(httpClient *retryablehttp.Client, host string, authToken string)
 	}
 
 	c := &Client{Client: httpClient, BasePath: BasePath, BaseURL: baseURL, UserAgent: DefaultUserAgent, AuthToken: authToken}
+	if claims, err := ParseClaims(authToken); err == nil && claims.ExpiresAt != nil {
+		c.TokenExpiresAt = claims.ExpiresAt.Time
+	}
 	c.common.Client = c
 	c.Blueprints = (*BlueprintsService)(&c.common)
 	c.ContextQuestions = (*ContextQuestionsService)(&c.common)
@@ -190,8 +251,30 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Requ
 }
 
 // Do executes an HTTP request.
-func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*http.Response, error) {
-	req = req.WithContext(ctx)
+func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (resp *http.Response, err error) {
+	ctx, span := c.startRequestSpan(ctx, req)
+	defer func() { endRequestSpan(span, resp, err) }()
+
+	// Fail before sending a request the API would reject, so a long
+	// apply stops with a clear message rather than a bare 401.
+	if !c.TokenExpiresAt.IsZero() && !time.Now().Before(c.TokenExpiresAt) {
+		return nil, &TokenExpiredError{ExpiresAt: c.TokenExpiresAt}
+	}
+
+	if c.ReadOnly && !isReadOnlyMethod(req.Method) {
+		return nil, &ReadOnlyError{Method: req.Method, Path: req.URL.Path}
+	}
+
+	if c.AuditLog != nil && !isReadOnlyMethod(req.Method) {
+		return c.doAudited(ctx, req, v)
+	}
+	return c.do(ctx, req, v)
+}
+
+// do sends the request and decodes the response body into v.
+func (c *Client) do(ctx context.Context, req *http.Request, v interface{}) (*http.Response, error) {
+	ctx = withRequestLogging(ctx)
+	req = req.WithContext(withRetrySafety(ctx, req))
 
 	retryableReq, err := retryablehttp.FromRequest(req)
 	if err != nil {
@@ -216,82 +299,57 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*htt
 		return resp, err
 	}
 
-	if v != nil {
-		if w, ok := v.(io.Writer); ok {
-			_, _ = io.Copy(w, resp.Body)
-		} else {
-			decErr := json.NewDecoder(resp.Body).Decode(v)
-			if decErr == io.EOF {
-				decErr = nil // ignore EOF errors caused by empty response body
-			}
-			if decErr != nil {
-				err = decErr
-			}
-		}
-	}
-
-	return resp, err
+	return resp, decodeBody(resp.Body, v)
 }
 
-// ErrorResponse represents the error response from the API.
-type Err struct {
-	Status      uint32   `json:"status"`
-	RequestId   string   `json:"request_id"`
-	Errors      []string `json:"errors"`
-	RequestPath string   `json:"request_path"`
-	AppVersion  string   `json:"app_version"`
-}
-
-type ErrorResponse struct {
-	Response *http.Response
-	Err      Err
-}
-
-func (r *ErrorResponse) Error() string {
-	return fmt.Sprintf("%v %v: [%d] %v - %v",
-		r.Response.Request.Method, r.Response.Request.URL,
-		r.Response.StatusCode, r.Err.RequestId, strings.Join(r.Err.Errors, ", "))
-}
-
-// CheckResponse checks the HTTP response for an error.
-func CheckResponse(r *http.Response) error {
-	if c := r.StatusCode; 200 <= c && c <= 299 {
+// decodeBody copies the body into v if it is an io.Writer, and decodes
+// it as JSON into v otherwise.
+func decodeBody(body io.Reader, v interface{}) error {
+	if v == nil {
 		return nil
 	}
-
-	// Authorization errors do not follow the standard ErrorResponse
-	// format
-	if r.StatusCode == 401 {
-		return &ErrorResponse{
-			Response: r,
-			Err: Err{
-				Status: 401,
-				Errors: []string{"Unauthorized"},
-			},
-		}
+	if w, ok := v.(io.Writer); ok {
+		_, _ = io.Copy(w, body)
+		return nil
 	}
-
-	errorResponse := &ErrorResponse{Response: r}
-	data, err := io.ReadAll(r.Body)
-	if err == nil && data != nil {
-		_ = json.Unmarshal(data, &errorResponse.Err)
+	err := json.NewDecoder(body).Decode(v)
+	if err == io.EOF {
+		err = nil // ignore EOF errors caused by empty response body
 	}
+	return err
+}
 
-	return errorResponse
+// isReadOnlyMethod reports whether a request with the method cannot
+// change anything.
+func isReadOnlyMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	default:
+		return false
+	}
 }
 
 type SystemHealthResponse struct {
 	Status string `json:"status"`
 }
 
-func (c *Client) Check() error {
-	shr, _, err := c.System.GetHealth(context.Background())
+// HealthCheckTimeout bounds Check, including its retries.
+const HealthCheckTimeout = 30 * time.Second
+
+// Check reports whether the Resourcely API is reachable and healthy. It
+// gives up after HealthCheckTimeout, or sooner if ctx is done.
+func (c *Client) Check(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, HealthCheckTimeout)
+	defer cancel()
+
+	shr, _, err := c.System.GetHealth(ctx)
 	if err != nil {
 		return err
 	}
 
 	if !(shr.Status == "ok") {
-		return fmt.Errorf("system/health: available: %s, body: %s", shr.Status, shr)
+		return fmt.Errorf("system/health: available: %s, body: %+v", shr.Status, shr)
 	}
 
 	return nil
@@ -299,22 +357,31 @@ func (c *Client) Check() error {
 
 // Model of Resourcley Auth Token claims
 type ResourcelyClaims struct {
-	// We currently only care about the tenant claim
 	Tenant string `json:"@resourcely/tenant"`
 	jwt.RegisteredClaims
 }
 
-// Returns the tenant name from the auth token
-func (c *Client) Tenant() (string, error) {
-	token, _, err := new(jwt.Parser).ParseUnverified(c.AuthToken, &ResourcelyClaims{})
+// ParseClaims returns the claims of an auth token without verifying
+// its signature. Only the Resourcely API can verify it.
+func ParseClaims(authToken string) (*ResourcelyClaims, error) {
+	token, _, err := new(jwt.Parser).ParseUnverified(authToken, &ResourcelyClaims{})
 	if err != nil {
-		return "", fmt.Errorf("Error parsing Resourcely auth token: %w", err)
+		return nil, fmt.Errorf("Error parsing Resourcely auth token: %w", err)
 	}
 
 	claims, ok := token.Claims.(*ResourcelyClaims)
 	if !ok {
-		return "", fmt.Errorf("Error parsing Resourcely auth token: invalid claims")
+		return nil, fmt.Errorf("Error parsing Resourcely auth token: invalid claims")
 	}
 
+	return claims, nil
+}
+
+// Returns the tenant name from the auth token
+func (c *Client) Tenant() (string, error) {
+	claims, err := ParseClaims(c.AuthToken)
+	if err != nil {
+		return "", err
+	}
 	return claims.Tenant, nil
 }
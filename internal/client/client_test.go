@@ -0,0 +1,87 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/client"
+)
+
+func TestClient_check(t *testing.T) {
+	status := "ok"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", client.MediaTypeJSON)
+		_, _ = w.Write([]byte(`{"status": "` + status + `"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	c := newTransportTestClient(t, server.URL, client.TransportConfig{})
+
+	if err := c.Check(context.Background()); err != nil {
+		t.Errorf("expected a healthy API, got %v", err)
+	}
+
+	status = "degraded"
+	if err := c.Check(context.Background()); err == nil {
+		t.Errorf("expected an error for a degraded API")
+	}
+}
+
+func TestClient_checkCancelled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(server.Close)
+
+	c := newTransportTestClient(t, server.URL, client.TransportConfig{
+		RetryWaitMin: time.Second,
+		RetryWaitMax: time.Second,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := c.Check(ctx)
+	if err == nil {
+		t.Fatalf("expected an error for an unavailable API")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("expected Check to stop retrying once ctx is done, took %s", elapsed)
+	}
+}
+
+func TestClient_readOnly(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method)
+		w.Header().Set("Content-Type", client.MediaTypeJSON)
+		_, _ = w.Write([]byte(`{"status": "ok"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	c := newTransportTestClient(t, server.URL, client.TransportConfig{})
+	c.ReadOnly = true
+
+	if err := c.Check(context.Background()); err != nil {
+		t.Errorf("expected GET to work in read-only mode, got %v", err)
+	}
+
+	for _, method := range []string{http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete} {
+		req, err := c.NewRequest(method, "api/v1/blueprints/1", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = c.Do(context.Background(), req, nil)
+		if !client.IsReadOnly(err) {
+			t.Errorf("expected %s to be refused in read-only mode, got %v", method, err)
+		}
+	}
+
+	if len(requests) != 1 || requests[0] != http.MethodGet {
+		t.Errorf("expected only the GET to reach the API, got %v", requests)
+	}
+}
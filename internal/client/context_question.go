@@ -16,6 +16,9 @@ type NewContextQuestion struct {
 
 type UpdatedContextQuestion struct {
 	SeriesId string `json:"-"`
+	// Version is the version the update replaces, or zero to
+	// replace whatever version is current.
+	Version int64 `json:"-"`
 	CommonContextQuestionFields
 }
 
@@ -35,10 +38,37 @@ type ContextQuestion struct {
 	Id       string `json:"id"`
 	SeriesId string `json:"series_id"`
 	Version  int64  `json:"version"`
+	// UpdatedBy is the user who created this version, if the API
+	// reports it.
+	UpdatedBy string `json:"updated_by,omitempty"`
 
 	CommonContextQuestionFields
 }
 
+// ContextQuestionListOptions filters and sorts the context questions
+// returned by ListContextQuestions. Empty filters are ignored.
+type ContextQuestionListOptions struct {
+	ListOptions
+
+	// Label matches the context question with exactly this label.
+	Label string
+	Qtype string
+	Scope string
+	// BlueprintCategories matches context questions that apply to any
+	// of these blueprint categories.
+	BlueprintCategories []string
+}
+
+func (o *ContextQuestionListOptions) encode() url.Values {
+	query := url.Values{}
+	o.ListOptions.encode(query)
+	encodeString(query, "label", o.Label)
+	encodeString(query, "qtype", o.Qtype)
+	encodeString(query, "scope", o.Scope)
+	encodeAll(query, "blueprint_category", o.BlueprintCategories)
+	return query
+}
+
 func (s *ContextQuestionsService) GetContextQuestionBySeriesId(ctx context.Context, seriesId string) (*ContextQuestion, *http.Response, error) {
 	path := fmt.Sprintf("%s/context-questions/series/%s", s.Client.BasePath, seriesId)
 	body, resp, err := s.Client.Get(ctx, path, url.Values{}, new(ContextQuestion))
@@ -48,6 +78,53 @@ func (s *ContextQuestionsService) GetContextQuestionBySeriesId(ctx context.Conte
 	return body.(*ContextQuestion), resp, nil
 }
 
+// GetContextQuestionByLabel returns the context question with this
+// label. It returns a nil context question if none match.
+func (s *ContextQuestionsService) GetContextQuestionByLabel(ctx context.Context, label string) (*ContextQuestion, *http.Response, error) {
+	// The API's filter may not be exact, so check every match
+	contextQuestions, resp, err := filterAll(ctx, s.ListContextQuestionsPaginator(&ContextQuestionListOptions{
+		ListOptions: ListOptions{SortField: "series_id"},
+		Label:       label,
+	}), func(cq ContextQuestion) bool { return cq.Label == label })
+	if err != nil {
+		return nil, resp, err
+	}
+
+	switch len(contextQuestions) {
+	case 0:
+		return nil, resp, nil
+	case 1:
+		return &contextQuestions[0], resp, nil
+	default:
+		return &contextQuestions[0], resp, fmt.Errorf("Found multiple context questions with the provided label. Expected just one.")
+	}
+}
+
+// ListContextQuestions returns a single page of context questions. Use
+// ListContextQuestionsPaginator to walk every page.
+func (s *ContextQuestionsService) ListContextQuestions(ctx context.Context, opts *ContextQuestionListOptions) (*QueryResponse[ContextQuestion], *http.Response, error) {
+	if opts == nil {
+		opts = &ContextQuestionListOptions{}
+	}
+	path := fmt.Sprintf("%s/context-questions", s.Client.BasePath)
+	body, resp, err := s.Client.Get(ctx, path, opts.encode(), new(QueryResponse[ContextQuestion]))
+	if err != nil {
+		return nil, resp, err
+	}
+	return body.(*QueryResponse[ContextQuestion]), resp, nil
+}
+
+func (s *ContextQuestionsService) ListContextQuestionsPaginator(opts *ContextQuestionListOptions) *Paginator[ContextQuestion] {
+	filters := ContextQuestionListOptions{}
+	if opts != nil {
+		filters = *opts
+	}
+	return newPaginator(filters.ListOptions, func(ctx context.Context, listOpts ListOptions) (*QueryResponse[ContextQuestion], *http.Response, error) {
+		filters.ListOptions = listOpts
+		return s.ListContextQuestions(ctx, &filters)
+	})
+}
+
 func (s *ContextQuestionsService) CreateContextQuestion(ctx context.Context, newContextQuestion *NewContextQuestion) (*ContextQuestion, *http.Response, error) {
 	path := fmt.Sprintf("%s/context-questions", s.Client.BasePath)
 	body, resp, err := s.Client.Post(ctx, path, newContextQuestion, new(ContextQuestion))
@@ -59,7 +136,7 @@ func (s *ContextQuestionsService) CreateContextQuestion(ctx context.Context, new
 
 func (s *ContextQuestionsService) UpdateContextQuestion(ctx context.Context, updatedContextQuestion *UpdatedContextQuestion) (*ContextQuestion, *http.Response, error) {
 	path := fmt.Sprintf("%s/context-questions/series/%s", s.Client.BasePath, updatedContextQuestion.SeriesId)
-	body, resp, err := s.Client.Put(ctx, path, updatedContextQuestion, new(ContextQuestion))
+	body, resp, err := s.Client.PutIfMatch(ctx, path, updatedContextQuestion.Version, updatedContextQuestion, new(ContextQuestion))
 	if err != nil {
 		return nil, resp, err
 	}
@@ -0,0 +1,181 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// HeaderRequestId is the response header that identifies a request in
+// the Resourcely API logs.
+const HeaderRequestId = "X-Request-Id"
+
+// Error kinds returned by the Resourcely API. An *ErrorResponse
+// unwraps to one of these, so callers can branch with errors.Is and
+// still reach the request id and error messages with errors.As.
+var (
+	ErrNotFound     = errors.New("not found")
+	ErrConflict     = errors.New("conflict")
+	ErrValidation   = errors.New("validation failed")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrForbidden    = errors.New("forbidden")
+	ErrRateLimited  = errors.New("rate limited")
+	ErrServer       = errors.New("server error")
+
+	// ErrVersionMismatch is returned for an update whose If-Match
+	// version is no longer the current version of the series.
+	ErrVersionMismatch = errors.New("version mismatch")
+
+	// ErrReadOnly is returned instead of sending a request that could
+	// change anything while the client is read-only.
+	ErrReadOnly = errors.New("read-only")
+)
+
+// ErrorResponse represents the error response from the API.
+type Err struct {
+	Status      uint32   `json:"status"`
+	RequestId   string   `json:"request_id"`
+	Errors      []string `json:"errors"`
+	RequestPath string   `json:"request_path"`
+	AppVersion  string   `json:"app_version"`
+}
+
+type ErrorResponse struct {
+	Response *http.Response
+	Err      Err
+}
+
+func (r *ErrorResponse) Error() string {
+	return fmt.Sprintf("%v %v: [%d] %v - %v",
+		r.Response.Request.Method, r.Response.Request.URL,
+		r.Response.StatusCode, r.Err.RequestId, strings.Join(r.Err.Errors, ", "))
+}
+
+// Unwrap returns the error kind for the response status code, or nil
+// if the status code does not map to a kind.
+func (r *ErrorResponse) Unwrap() error {
+	return errorKind(r.Response.StatusCode)
+}
+
+func errorKind(statusCode int) error {
+	switch {
+	case statusCode == http.StatusNotFound:
+		return ErrNotFound
+	case statusCode == http.StatusConflict:
+		return ErrConflict
+	case statusCode == http.StatusPreconditionFailed:
+		return ErrVersionMismatch
+	case statusCode == http.StatusBadRequest, statusCode == http.StatusUnprocessableEntity:
+		return ErrValidation
+	case statusCode == http.StatusUnauthorized:
+		return ErrUnauthorized
+	case statusCode == http.StatusForbidden:
+		return ErrForbidden
+	case statusCode == http.StatusTooManyRequests:
+		return ErrRateLimited
+	case statusCode >= 500:
+		return ErrServer
+	default:
+		return nil
+	}
+}
+
+// IsNotFound reports whether err is a Resourcely API not found error.
+func IsNotFound(err error) bool { return errors.Is(err, ErrNotFound) }
+
+// IsConflict reports whether err is a Resourcely API conflict error.
+func IsConflict(err error) bool { return errors.Is(err, ErrConflict) }
+
+// IsVersionMismatch reports whether the Resourcely API rejected an
+// update because the series changed since the version it was based on.
+func IsVersionMismatch(err error) bool { return errors.Is(err, ErrVersionMismatch) }
+
+// IsValidation reports whether the Resourcely API rejected the request
+// body as invalid.
+func IsValidation(err error) bool { return errors.Is(err, ErrValidation) }
+
+// IsUnauthorized reports whether the Resourcely API rejected the auth
+// token.
+func IsUnauthorized(err error) bool { return errors.Is(err, ErrUnauthorized) }
+
+// IsForbidden reports whether the auth token is not allowed to make
+// the request.
+func IsForbidden(err error) bool { return errors.Is(err, ErrForbidden) }
+
+// IsRateLimited reports whether the Resourcely API is throttling
+// requests.
+func IsRateLimited(err error) bool { return errors.Is(err, ErrRateLimited) }
+
+// IsServerError reports whether the Resourcely API failed to handle the
+// request.
+func IsServerError(err error) bool { return errors.Is(err, ErrServer) }
+
+// IsReadOnly reports whether err is a request the client refused to
+// send because it is read-only.
+func IsReadOnly(err error) bool { return errors.Is(err, ErrReadOnly) }
+
+// ReadOnlyError is returned instead of sending a POST, PUT, PATCH or
+// DELETE request while the client is read-only. It unwraps to
+// ErrReadOnly.
+type ReadOnlyError struct {
+	Method string
+	Path   string
+}
+
+func (e *ReadOnlyError) Error() string {
+	return fmt.Sprintf("%s %s: refusing to change Resourcely in read-only mode", e.Method, e.Path)
+}
+
+func (e *ReadOnlyError) Unwrap() error {
+	return ErrReadOnly
+}
+
+// TokenExpiredError is returned instead of sending a request once the
+// auth token has expired. It unwraps to ErrUnauthorized.
+type TokenExpiredError struct {
+	ExpiresAt time.Time
+}
+
+func (e *TokenExpiredError) Error() string {
+	return fmt.Sprintf("the Resourcely auth token expired at %s", e.ExpiresAt.UTC().Format(time.RFC3339))
+}
+
+func (e *TokenExpiredError) Unwrap() error {
+	return ErrUnauthorized
+}
+
+// CheckResponse checks the HTTP response for an error.
+func CheckResponse(r *http.Response) error {
+	if c := r.StatusCode; 200 <= c && c <= 299 {
+		return nil
+	}
+
+	errorResponse := &ErrorResponse{Response: r}
+
+	// Authorization errors do not follow the standard ErrorResponse
+	// format
+	if r.StatusCode == 401 {
+		errorResponse.Err = Err{
+			Status: 401,
+			Errors: []string{"Unauthorized"},
+		}
+	} else {
+		data, err := io.ReadAll(r.Body)
+		if err == nil && data != nil {
+			_ = json.Unmarshal(data, &errorResponse.Err)
+		}
+	}
+
+	if errorResponse.Err.Status == 0 {
+		errorResponse.Err.Status = uint32(r.StatusCode)
+	}
+	if errorResponse.Err.RequestId == "" {
+		errorResponse.Err.RequestId = r.Header.Get(HeaderRequestId)
+	}
+
+	return errorResponse
+}
@@ -0,0 +1,206 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/client"
+	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/fakeapi"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+func TestErrorResponse_notFound(t *testing.T) {
+	_, c := newFakeClient(t)
+
+	_, _, err := c.Blueprints.GetBlueprintBySeriesId(context.Background(), "00000000-0000-0000-0000-000000000000")
+	if !client.IsNotFound(err) {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+
+	var errorResponse *client.ErrorResponse
+	if !errors.As(err, &errorResponse) {
+		t.Fatalf("expected an *ErrorResponse, got %T", err)
+	}
+	if errorResponse.Err.RequestId == "" {
+		t.Errorf("expected the error to carry a request id")
+	}
+	if len(errorResponse.Err.Errors) == 0 {
+		t.Errorf("expected the error to carry the API error messages")
+	}
+}
+
+func TestErrorResponse_unauthorized(t *testing.T) {
+	server := fakeapi.NewServer()
+	t.Cleanup(server.Close)
+
+	c, err := client.NewClient(nil, server.URL, "not-the-token")
+	if err != nil {
+		t.Fatalf("creating client: %v", err)
+	}
+
+	_, _, err = c.System.GetHealth(context.Background())
+	if !client.IsUnauthorized(err) {
+		t.Fatalf("expected an unauthorized error, got %v", err)
+	}
+
+	var errorResponse *client.ErrorResponse
+	if !errors.As(err, &errorResponse) {
+		t.Fatalf("expected an *ErrorResponse, got %T", err)
+	}
+	if errorResponse.Err.RequestId == "" {
+		t.Errorf("expected the request id to be read from the %s header", client.HeaderRequestId)
+	}
+}
+
+func TestErrorResponse_conflictAndValidation(t *testing.T) {
+	ctx := context.Background()
+	_, c := newFakeClient(t)
+
+	newContextQuestion := &client.NewContextQuestion{
+		CommonContextQuestionFields: client.CommonContextQuestionFields{
+			Label:  "duplicate",
+			Prompt: "prompt",
+			Qtype:  "QTYPE_TEXT",
+			Scope:  "SCOPE_TENANT",
+		},
+	}
+	if _, _, err := c.ContextQuestions.CreateContextQuestion(ctx, newContextQuestion); err != nil {
+		t.Fatalf("creating context question: %v", err)
+	}
+
+	_, _, err := c.ContextQuestions.CreateContextQuestion(ctx, newContextQuestion)
+	if !client.IsConflict(err) {
+		t.Errorf("expected a conflict error, got %v", err)
+	}
+
+	_, _, err = c.ContextQuestions.CreateContextQuestion(ctx, &client.NewContextQuestion{})
+	if !client.IsValidation(err) {
+		t.Errorf("expected a validation error, got %v", err)
+	}
+}
+
+func TestErrorResponse_versionMismatch(t *testing.T) {
+	ctx := context.Background()
+	_, c := newFakeClient(t)
+
+	fields := client.CommonContextQuestionFields{
+		Label:  "versioned",
+		Prompt: "prompt",
+		Qtype:  "QTYPE_TEXT",
+		Scope:  "SCOPE_TENANT",
+	}
+	contextQuestion, _, err := c.ContextQuestions.CreateContextQuestion(ctx, &client.NewContextQuestion{CommonContextQuestionFields: fields})
+	if err != nil {
+		t.Fatalf("creating context question: %v", err)
+	}
+
+	fields.Prompt = "updated prompt"
+	updated, _, err := c.ContextQuestions.UpdateContextQuestion(ctx, &client.UpdatedContextQuestion{
+		SeriesId:                    contextQuestion.SeriesId,
+		Version:                     contextQuestion.Version,
+		CommonContextQuestionFields: fields,
+	})
+	if err != nil {
+		t.Fatalf("updating the current version: %v", err)
+	}
+
+	// A second update based on the original version must not overwrite
+	// the first.
+	_, _, err = c.ContextQuestions.UpdateContextQuestion(ctx, &client.UpdatedContextQuestion{
+		SeriesId:                    contextQuestion.SeriesId,
+		Version:                     contextQuestion.Version,
+		CommonContextQuestionFields: fields,
+	})
+	if !client.IsVersionMismatch(err) {
+		t.Errorf("expected a version mismatch error, got %v", err)
+	}
+
+	current, _, err := c.ContextQuestions.GetContextQuestionBySeriesId(ctx, contextQuestion.SeriesId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if current.Version != updated.Version {
+		t.Errorf("expected the series to stay at version %d, got %d", updated.Version, current.Version)
+	}
+}
+
+func TestErrorResponse_kinds(t *testing.T) {
+	tests := []struct {
+		statusCode int
+		kind       error
+	}{
+		{http.StatusBadRequest, client.ErrValidation},
+		{http.StatusUnauthorized, client.ErrUnauthorized},
+		{http.StatusForbidden, client.ErrForbidden},
+		{http.StatusNotFound, client.ErrNotFound},
+		{http.StatusConflict, client.ErrConflict},
+		{http.StatusUnprocessableEntity, client.ErrValidation},
+		{http.StatusTooManyRequests, client.ErrRateLimited},
+		{http.StatusInternalServerError, client.ErrServer},
+		{http.StatusServiceUnavailable, client.ErrServer},
+		{http.StatusTeapot, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(http.StatusText(test.statusCode), func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(test.statusCode)
+			}))
+			t.Cleanup(server.Close)
+
+			httpClient := retryablehttp.NewClient()
+			httpClient.RetryMax = 0
+			httpClient.ErrorHandler = retryablehttp.PassthroughErrorHandler
+			c, err := client.NewClient(httpClient, server.URL, "token")
+			if err != nil {
+				t.Fatalf("creating client: %v", err)
+			}
+
+			_, _, err = c.System.GetHealth(context.Background())
+			var errorResponse *client.ErrorResponse
+			if !errors.As(err, &errorResponse) {
+				t.Fatalf("expected an *ErrorResponse, got %v", err)
+			}
+			if errorResponse.Err.Status != uint32(test.statusCode) {
+				t.Errorf("expected status %d, got %d", test.statusCode, errorResponse.Err.Status)
+			}
+			if kind := errors.Unwrap(err); kind != test.kind {
+				t.Errorf("expected kind %v, got %v", test.kind, kind)
+			}
+		})
+	}
+}
+
+func TestTokenExpiredError(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+	}))
+	t.Cleanup(server.Close)
+
+	expiresAt := time.Now().Add(-time.Minute)
+	c, err := client.NewClient(nil, server.URL, fakeapi.NewToken(fakeapi.DefaultTenant, expiresAt))
+	if err != nil {
+		t.Fatalf("creating client: %v", err)
+	}
+
+	_, _, err = c.System.GetHealth(context.Background())
+	var expiredError *client.TokenExpiredError
+	if !errors.As(err, &expiredError) {
+		t.Fatalf("expected a *TokenExpiredError, got %v", err)
+	}
+	if !expiredError.ExpiresAt.Equal(expiresAt.Truncate(time.Second)) {
+		t.Errorf("expected the error to carry the expiry %s, got %s", expiresAt, expiredError.ExpiresAt)
+	}
+	if !client.IsUnauthorized(err) {
+		t.Errorf("expected an expired token to be an unauthorized error")
+	}
+	if requests != 0 {
+		t.Errorf("expected no request to be sent with an expired token, got %d", requests)
+	}
+}
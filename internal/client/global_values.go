@@ -13,6 +13,9 @@ type GlobalValue struct {
 	Id       string `json:"id"`
 	SeriesId string `json:"series_id"`
 	Version  int64  `json:"version"`
+	// UpdatedBy is the user who created this version, if the API
+	// reports it.
+	UpdatedBy string `json:"updated_by,omitempty"`
 
 	CommonGlobalValueFields
 
@@ -27,10 +30,15 @@ type NewGlobalValue struct {
 
 	Key  string `json:"key"`
 	Type string `json:"type"`
+
+	IsDeprecated bool `json:"is_deprecated"`
 }
 
 type UpdatedGlobalValue struct {
 	SeriesId string `json:"-"`
+	// Version is the version the update replaces, or zero to
+	// replace whatever version is current.
+	Version int64 `json:"-"`
 
 	CommonGlobalValueFields
 
@@ -50,13 +58,29 @@ type GlobalValueOption struct {
 	Value       interface{} `json:"value"`
 }
 
-type GlobalValuesQueryResponse struct {
-	Page       int `json:"page,omitempty"`
-	PageSize   int `json:"page_size,omitempty"`
-	TotalPages int `json:"total_pages,omitempty"`
-	TotalItems int `json:"total_items"`
+type GlobalValuesQueryResponse = QueryResponse[GlobalValue]
+
+// GlobalValueListOptions filters and sorts the global values returned
+// by ListGlobalValues. Empty filters are ignored.
+type GlobalValueListOptions struct {
+	ListOptions
+
+	// Key matches the global value with exactly this key.
+	Key string
+	// Name matches global values with exactly this name.
+	Name         string
+	Type         string
+	IsDeprecated *bool
+}
 
-	PageItems []GlobalValue `json:"page_items"`
+func (o *GlobalValueListOptions) encode() url.Values {
+	query := url.Values{}
+	o.ListOptions.encode(query)
+	encodeString(query, "key", o.Key)
+	encodeString(query, "name", o.Name)
+	encodeString(query, "type", o.Type)
+	encodeBool(query, "is_deprecated", o.IsDeprecated)
+	return query
 }
 
 func (s *GlobalValuesService) GetGlobalValueBySeriesId(ctx context.Context, seriesId string) (*GlobalValue, *http.Response, error) {
@@ -69,18 +93,15 @@ func (s *GlobalValuesService) GetGlobalValueBySeriesId(ctx context.Context, seri
 }
 
 func (s *GlobalValuesService) GetGlobalValueByKey(ctx context.Context, key string) (*GlobalValue, *http.Response, error) {
-	query := url.Values{}
-	query.Set("key", key)
-	query.Set("page_size", "2")
-	query.Set("sort_field", "series_id")
-
-	path := fmt.Sprintf("%s/presets", s.Client.BasePath)
-	body, resp, err := s.Client.Get(ctx, path, query, new(GlobalValuesQueryResponse))
+	// The API's filter may not be exact, so check every match
+	globalValues, resp, err := filterAll(ctx, s.ListGlobalValuesPaginator(&GlobalValueListOptions{
+		ListOptions: ListOptions{SortField: "series_id"},
+		Key:         key,
+	}), func(v GlobalValue) bool { return v.Key == key })
 	if err != nil {
 		return nil, resp, err
 	}
 
-	globalValues := body.(*GlobalValuesQueryResponse).PageItems
 	switch len(globalValues) {
 	case 0:
 		return nil, resp, nil
@@ -91,6 +112,31 @@ func (s *GlobalValuesService) GetGlobalValueByKey(ctx context.Context, key strin
 	}
 }
 
+// ListGlobalValues returns a single page of global values. Use
+// ListGlobalValuesPaginator to walk every page.
+func (s *GlobalValuesService) ListGlobalValues(ctx context.Context, opts *GlobalValueListOptions) (*QueryResponse[GlobalValue], *http.Response, error) {
+	if opts == nil {
+		opts = &GlobalValueListOptions{}
+	}
+	path := fmt.Sprintf("%s/presets", s.Client.BasePath)
+	body, resp, err := s.Client.Get(ctx, path, opts.encode(), new(QueryResponse[GlobalValue]))
+	if err != nil {
+		return nil, resp, err
+	}
+	return body.(*QueryResponse[GlobalValue]), resp, nil
+}
+
+func (s *GlobalValuesService) ListGlobalValuesPaginator(opts *GlobalValueListOptions) *Paginator[GlobalValue] {
+	filters := GlobalValueListOptions{}
+	if opts != nil {
+		filters = *opts
+	}
+	return newPaginator(filters.ListOptions, func(ctx context.Context, listOpts ListOptions) (*QueryResponse[GlobalValue], *http.Response, error) {
+		filters.ListOptions = listOpts
+		return s.ListGlobalValues(ctx, &filters)
+	})
+}
+
 func (s *GlobalValuesService) CreateGlobalValue(ctx context.Context, newGlobalValue *NewGlobalValue) (*GlobalValue, *http.Response, error) {
 	path := fmt.Sprintf("%s/presets", s.Client.BasePath)
 	body, resp, err := s.Client.Post(ctx, path, newGlobalValue, new(GlobalValue))
@@ -102,7 +148,7 @@ func (s *GlobalValuesService) CreateGlobalValue(ctx context.Context, newGlobalVa
 
 func (s *GlobalValuesService) UpdateGlobalValue(ctx context.Context, updatedGlobalValue *UpdatedGlobalValue) (*GlobalValue, *http.Response, error) {
 	path := fmt.Sprintf("%s/presets/series/%s", s.Client.BasePath, updatedGlobalValue.SeriesId)
-	body, resp, err := s.Client.Put(ctx, path, updatedGlobalValue, new(GlobalValue))
+	body, resp, err := s.Client.PutIfMatch(ctx, path, updatedGlobalValue.Version, updatedGlobalValue, new(GlobalValue))
 	if err != nil {
 		return nil, resp, err
 	}
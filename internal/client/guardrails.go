@@ -13,7 +13,10 @@ type Guardrail struct {
 	Id       string `json:"id"`
 	SeriesId string `json:"series_id"`
 	Version  int64  `json:"version"`
-	Scope    string `json:"scope"`
+	// UpdatedBy is the user who created this version, if the API
+	// reports it.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	Scope     string `json:"scope"`
 
 	CommonGuardrailFields
 
@@ -34,6 +37,9 @@ type NewGuardrail struct {
 
 type UpdatedGuardrail struct {
 	SeriesId string `json:"-"`
+	// Version is the version the update replaces, or zero to
+	// replace whatever version is current.
+	Version int64 `json:"-"`
 
 	CommonGuardrailFields
 
@@ -50,6 +56,30 @@ type CommonGuardrailFields struct {
 	Content     string `json:"content"`
 }
 
+// GuardrailListOptions filters and sorts the guardrails returned by
+// ListGuardrails. Empty filters are ignored.
+type GuardrailListOptions struct {
+	ListOptions
+
+	// Name matches guardrails with exactly this name.
+	Name     string
+	Provider string
+	// Categories matches guardrails in any of these categories.
+	Categories []string
+	// States matches guardrails in any of these states.
+	States []string
+}
+
+func (o *GuardrailListOptions) encode() url.Values {
+	query := url.Values{}
+	o.ListOptions.encode(query)
+	encodeString(query, "name", o.Name)
+	encodeString(query, "provider", o.Provider)
+	encodeAll(query, "category", o.Categories)
+	encodeAll(query, "state", o.States)
+	return query
+}
+
 func (s *GuardrailsService) GetGuardrailBySeriesId(ctx context.Context, seriesId string) (*Guardrail, *http.Response, error) {
 	path := fmt.Sprintf("%s/guardrails/series/%s", s.Client.BasePath, seriesId)
 	body, resp, err := s.Client.Get(ctx, path, url.Values{}, new(Guardrail))
@@ -59,6 +89,53 @@ func (s *GuardrailsService) GetGuardrailBySeriesId(ctx context.Context, seriesId
 	return body.(*Guardrail), resp, nil
 }
 
+// GetGuardrailByName returns the guardrail with exactly this name. It
+// returns a nil guardrail if none match, and an error if several do.
+func (s *GuardrailsService) GetGuardrailByName(ctx context.Context, name string) (*Guardrail, *http.Response, error) {
+	// The API's filter may not be exact, so check every match
+	guardrails, resp, err := filterAll(ctx, s.ListGuardrailsPaginator(&GuardrailListOptions{
+		ListOptions: ListOptions{SortField: "series_id"},
+		Name:        name,
+	}), func(g Guardrail) bool { return g.Name == name })
+	if err != nil {
+		return nil, resp, err
+	}
+
+	switch len(guardrails) {
+	case 0:
+		return nil, resp, nil
+	case 1:
+		return &guardrails[0], resp, nil
+	default:
+		return &guardrails[0], resp, fmt.Errorf("Found multiple guardrails with the provided name. Expected just one.")
+	}
+}
+
+// ListGuardrails returns a single page of guardrails. Use
+// ListGuardrailsPaginator to walk every page.
+func (s *GuardrailsService) ListGuardrails(ctx context.Context, opts *GuardrailListOptions) (*QueryResponse[Guardrail], *http.Response, error) {
+	if opts == nil {
+		opts = &GuardrailListOptions{}
+	}
+	path := fmt.Sprintf("%s/guardrails", s.Client.BasePath)
+	body, resp, err := s.Client.Get(ctx, path, opts.encode(), new(QueryResponse[Guardrail]))
+	if err != nil {
+		return nil, resp, err
+	}
+	return body.(*QueryResponse[Guardrail]), resp, nil
+}
+
+func (s *GuardrailsService) ListGuardrailsPaginator(opts *GuardrailListOptions) *Paginator[Guardrail] {
+	filters := GuardrailListOptions{}
+	if opts != nil {
+		filters = *opts
+	}
+	return newPaginator(filters.ListOptions, func(ctx context.Context, listOpts ListOptions) (*QueryResponse[Guardrail], *http.Response, error) {
+		filters.ListOptions = listOpts
+		return s.ListGuardrails(ctx, &filters)
+	})
+}
+
 func (s *GuardrailsService) CreateGuardrail(ctx context.Context, newGuardrail *NewGuardrail) (*Guardrail, *http.Response, error) {
 	path := fmt.Sprintf("%s/guardrails", s.Client.BasePath)
 	body, resp, err := s.Client.Post(ctx, path, newGuardrail, new(Guardrail))
@@ -70,7 +147,7 @@ func (s *GuardrailsService) CreateGuardrail(ctx context.Context, newGuardrail *N
 
 func (s *GuardrailsService) UpdateGuardrail(ctx context.Context, updatedGuardrail *UpdatedGuardrail) (*Guardrail, *http.Response, error) {
 	path := fmt.Sprintf("%s/guardrails/series/%s", s.Client.BasePath, updatedGuardrail.SeriesId)
-	body, resp, err := s.Client.Put(ctx, path, updatedGuardrail, new(Guardrail))
+	body, resp, err := s.Client.PutIfMatch(ctx, path, updatedGuardrail.Version, updatedGuardrail, new(Guardrail))
 	if err != nil {
 		return nil, resp, err
 	}
@@ -0,0 +1,171 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// LogSubsystem is the tflog subsystem for the Resourcely API requests.
+// Its level can be set on its own with the TF_LOG_PROVIDER_RESOURCELY_API
+// environment variable.
+const LogSubsystem = "api"
+
+const logSubsystemLevelVar = "TF_LOG_PROVIDER_RESOURCELY_API"
+
+type attemptsKey struct{}
+
+// withRequestLogging adds the API log subsystem to ctx, along with a
+// counter of the attempts to send a request.
+func withRequestLogging(ctx context.Context) context.Context {
+	ctx = tflog.NewSubsystem(ctx, LogSubsystem, tflog.WithLevelFromEnv(logSubsystemLevelVar))
+	return context.WithValue(ctx, attemptsKey{}, new(int32))
+}
+
+// loggingTransport logs every attempt to send a request to the
+// Resourcely API, including retries. Request and response bodies are
+// logged only at TRACE, with their secrets redacted.
+type loggingTransport struct {
+	next http.RoundTripper
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	fields := map[string]any{
+		"http_method": req.Method,
+		"http_path":   req.URL.Path,
+	}
+	if attempts, ok := ctx.Value(attemptsKey{}).(*int32); ok {
+		fields["attempt"] = atomic.AddInt32(attempts, 1)
+	}
+
+	tflog.SubsystemDebug(ctx, LogSubsystem, "Sending Resourcely API request", fields)
+	tflog.SubsystemTrace(ctx, LogSubsystem, "Resourcely API request", withFields(fields, map[string]any{
+		"request_headers": newLazyField(func() any { return redactHeaders(req.Header) }),
+		"request_body":    newLazyField(func() any { return requestBody(req) }),
+	}))
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	fields["latency_ms"] = time.Since(start).Milliseconds()
+
+	if err != nil {
+		tflog.SubsystemDebug(ctx, LogSubsystem, "Resourcely API request failed", withFields(fields, map[string]any{
+			"error": err.Error(),
+		}))
+		return resp, err
+	}
+
+	fields["http_status"] = resp.StatusCode
+	fields["request_id"] = resp.Header.Get(HeaderRequestId)
+	tflog.SubsystemDebug(ctx, LogSubsystem, "Received Resourcely API response", fields)
+	tflog.SubsystemTrace(ctx, LogSubsystem, "Resourcely API response", withFields(fields, map[string]any{
+		"response_body": newLazyField(func() any { return responseBody(resp) }),
+	}))
+
+	return resp, nil
+}
+
+// requestBody returns the redacted body of the request, without
+// consuming it.
+func requestBody(req *http.Request) string {
+	if req.GetBody == nil {
+		return ""
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return ""
+	}
+	defer body.Close()
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return ""
+	}
+	return redactBody(data)
+}
+
+// responseBody returns the redacted body of the response. The body must
+// be read to log it, so it puts a copy back for the caller.
+func responseBody(resp *http.Response) string {
+	data, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+	if err != nil {
+		return ""
+	}
+	return redactBody(data)
+}
+
+// redactBody redacts the secrets in a JSON body. Bodies that are not
+// JSON are left out, because their secrets cannot be found.
+func redactBody(data []byte) string {
+	if len(data) == 0 {
+		return ""
+	}
+	redacted := redactJSON(data)
+	if redacted == nil {
+		return "(not JSON)"
+	}
+	return string(redacted)
+}
+
+// redactHeaders returns the request headers with the auth token and
+// any other credentials redacted.
+func redactHeaders(header http.Header) map[string]string {
+	headers := make(map[string]string, len(header))
+	for name, values := range header {
+		if name == HeaderToken || isSecretFieldName(name) {
+			headers[name] = Redacted
+			continue
+		}
+		headers[name] = strings.Join(values, ", ")
+	}
+	return headers
+}
+
+func withFields(fields map[string]any, more map[string]any) map[string]any {
+	merged := make(map[string]any, len(fields)+len(more))
+	for k, v := range fields {
+		merged[k] = v
+	}
+	for k, v := range more {
+		merged[k] = v
+	}
+	return merged
+}
+
+// lazyField is a log field computed only when a message that includes
+// it is written. The TRACE fields read and redact whole bodies, which
+// would be wasted on every request logged at a higher level.
+type lazyField struct {
+	once    sync.Once
+	compute func() any
+	value   any
+}
+
+func newLazyField(compute func() any) *lazyField {
+	return &lazyField{compute: compute}
+}
+
+func (f *lazyField) get() any {
+	f.once.Do(func() { f.value = f.compute() })
+	return f.value
+}
+
+func (f *lazyField) String() string {
+	return fmt.Sprint(f.get())
+}
+
+func (f *lazyField) MarshalJSON() ([]byte, error) {
+	return json.Marshal(f.get())
+}
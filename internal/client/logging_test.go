@@ -0,0 +1,53 @@
+package client_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+
+	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/client"
+)
+
+func TestClient_logsRequests(t *testing.T) {
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	server, c := newFakeClient(t)
+
+	body := map[string]interface{}{"name": "logged", "password": "hunter2"}
+	_, _, _ = c.Post(ctx, "api/v1/guardrails", body, nil)
+
+	logged := output.String()
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var responses []map[string]interface{}
+	for _, entry := range entries {
+		if entry["@module"] != "provider."+client.LogSubsystem {
+			t.Errorf("expected entries in the %s subsystem, got %v", client.LogSubsystem, entry["@module"])
+		}
+		if entry["@message"] == "Received Resourcely API response" {
+			responses = append(responses, entry)
+		}
+	}
+	if len(responses) == 0 {
+		t.Fatalf("expected a response to be logged, got %v", entries)
+	}
+	for _, field := range []string{"http_method", "http_path", "http_status", "latency_ms", "attempt", "request_id"} {
+		if _, ok := responses[0][field]; !ok {
+			t.Errorf("expected %s in the response entry, got %v", field, responses[0])
+		}
+	}
+
+	if strings.Contains(logged, "hunter2") || strings.Contains(logged, server.Token) {
+		t.Errorf("expected secrets to be redacted from the log")
+	}
+	if !strings.Contains(logged, "logged") {
+		t.Errorf("expected the request body to be logged at TRACE")
+	}
+}
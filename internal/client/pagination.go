@@ -0,0 +1,187 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+const (
+	SortAscending  = "asc"
+	SortDescending = "desc"
+)
+
+// QueryResponse is a single page of results from a list endpoint.
+type QueryResponse[T any] struct {
+	Page       int `json:"page,omitempty"`
+	PageSize   int `json:"page_size,omitempty"`
+	TotalPages int `json:"total_pages,omitempty"`
+	TotalItems int `json:"total_items"`
+
+	PageItems []T `json:"page_items"`
+}
+
+// ListOptions holds the paging and sorting options shared by every list
+// endpoint. Zero values are omitted, leaving the choice to the API.
+type ListOptions struct {
+	// Page is the 1-based page to fetch.
+	Page     int
+	PageSize int
+
+	SortField string
+	// SortOrder is either SortAscending or SortDescending.
+	SortOrder string
+}
+
+func (o ListOptions) encode(query url.Values) {
+	if o.Page > 0 {
+		query.Set("page", strconv.Itoa(o.Page))
+	}
+	if o.PageSize > 0 {
+		query.Set("page_size", strconv.Itoa(o.PageSize))
+	}
+	if o.SortField != "" {
+		query.Set("sort_field", o.SortField)
+	}
+	if o.SortOrder != "" {
+		query.Set("sort_order", o.SortOrder)
+	}
+}
+
+// Paginator walks every page of a list endpoint, following the page,
+// page_size and total_pages fields of each response.
+//
+//	paginator := client.Blueprints.ListBlueprintsPaginator(opts)
+//	for paginator.Next(ctx) {
+//		for _, blueprint := range paginator.Items() {
+//			...
+//		}
+//	}
+//	if err := paginator.Err(); err != nil {
+//		...
+//	}
+type Paginator[T any] struct {
+	fetch func(ctx context.Context, page int) (*QueryResponse[T], *http.Response, error)
+
+	nextPage int
+	done     bool
+
+	current *QueryResponse[T]
+	resp    *http.Response
+	err     error
+}
+
+func newPaginator[T any](
+	opts ListOptions,
+	list func(ctx context.Context, opts ListOptions) (*QueryResponse[T], *http.Response, error),
+) *Paginator[T] {
+	nextPage := opts.Page
+	if nextPage < 1 {
+		nextPage = 1
+	}
+
+	return &Paginator[T]{
+		nextPage: nextPage,
+		fetch: func(ctx context.Context, page int) (*QueryResponse[T], *http.Response, error) {
+			opts.Page = page
+			return list(ctx, opts)
+		},
+	}
+}
+
+// Next fetches the next page. It returns false once every page has
+// been fetched or a request fails; check Err to tell them apart.
+func (p *Paginator[T]) Next(ctx context.Context) bool {
+	if p.done {
+		return false
+	}
+
+	page, resp, err := p.fetch(ctx, p.nextPage)
+	p.resp = resp
+	if err != nil {
+		p.err = err
+		p.done = true
+		return false
+	}
+
+	p.current = page
+	p.nextPage++
+
+	// The API omits total_pages when there are no results. Stop on an
+	// empty page too, so a misbehaving server cannot loop us forever.
+	if p.nextPage > page.TotalPages || len(page.PageItems) == 0 {
+		p.done = true
+	}
+
+	return true
+}
+
+// Items returns the entities on the current page.
+func (p *Paginator[T]) Items() []T {
+	if p.current == nil {
+		return nil
+	}
+	return p.current.PageItems
+}
+
+// Page returns the current page, including its paging metadata.
+func (p *Paginator[T]) Page() *QueryResponse[T] {
+	return p.current
+}
+
+// Response returns the HTTP response for the most recent request.
+func (p *Paginator[T]) Response() *http.Response {
+	return p.resp
+}
+
+// Err returns the error, if any, that stopped the iteration.
+func (p *Paginator[T]) Err() error {
+	return p.err
+}
+
+// All fetches every remaining page and returns their entities.
+func (p *Paginator[T]) All(ctx context.Context) ([]T, error) {
+	var items []T
+	for p.Next(ctx) {
+		items = append(items, p.Items()...)
+	}
+	return items, p.Err()
+}
+
+// filterAll fetches every remaining page and returns the entities that
+// match. Lookups by name check each entity themselves, instead of
+// trusting the API to filter exactly.
+func filterAll[T any](ctx context.Context, p *Paginator[T], match func(T) bool) ([]T, *http.Response, error) {
+	var matches []T
+	for p.Next(ctx) {
+		for _, item := range p.Items() {
+			if match(item) {
+				matches = append(matches, item)
+			}
+		}
+	}
+	return matches, p.Response(), p.Err()
+}
+
+// encodeAll adds every value of a multi-valued filter as a repeated
+// query parameter.
+func encodeAll(query url.Values, key string, values []string) {
+	for _, value := range values {
+		query.Add(key, value)
+	}
+}
+
+// encodeBool adds an optional boolean filter to the query.
+func encodeBool(query url.Values, key string, value *bool) {
+	if value != nil {
+		query.Set(key, strconv.FormatBool(*value))
+	}
+}
+
+// encodeString adds an optional string filter to the query.
+func encodeString(query url.Values, key string, value string) {
+	if value != "" {
+		query.Set(key, value)
+	}
+}
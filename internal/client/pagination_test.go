@@ -0,0 +1,170 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/client"
+	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/fakeapi"
+)
+
+func newFakeClient(t *testing.T) (*fakeapi.Server, *client.Client) {
+	server := fakeapi.NewServer()
+	t.Cleanup(server.Close)
+
+	c, err := client.NewClient(nil, server.URL, server.Token)
+	if err != nil {
+		t.Fatalf("creating client: %v", err)
+	}
+	return server, c
+}
+
+func TestPaginator_walksEveryPage(t *testing.T) {
+	ctx := context.Background()
+	_, c := newFakeClient(t)
+
+	for i := 0; i < 5; i++ {
+		_, _, err := c.Guardrails.CreateGuardrail(ctx, &client.NewGuardrail{
+			CommonGuardrailFields: client.CommonGuardrailFields{
+				Name:     fmt.Sprintf("guardrail_%d", i),
+				Provider: "PROVIDER_AMAZON",
+				Category: "GUARDRAIL_BEST_PRACTICES",
+				State:    "GUARDRAIL_STATE_ACTIVE",
+				Content:  "content",
+			},
+		})
+		if err != nil {
+			t.Fatalf("creating guardrail %d: %v", i, err)
+		}
+	}
+
+	paginator := c.Guardrails.ListGuardrailsPaginator(&client.GuardrailListOptions{
+		ListOptions: client.ListOptions{PageSize: 2, SortField: "name", SortOrder: client.SortDescending},
+	})
+
+	pages := 0
+	var names []string
+	for paginator.Next(ctx) {
+		pages++
+		for _, guardrail := range paginator.Items() {
+			names = append(names, guardrail.Name)
+		}
+	}
+	if err := paginator.Err(); err != nil {
+		t.Fatalf("listing guardrails: %v", err)
+	}
+
+	if pages != 3 {
+		t.Errorf("expected 3 pages, got %d", pages)
+	}
+	expected := []string{"guardrail_4", "guardrail_3", "guardrail_2", "guardrail_1", "guardrail_0"}
+	if fmt.Sprint(names) != fmt.Sprint(expected) {
+		t.Errorf("expected %v, got %v", expected, names)
+	}
+}
+
+func TestPaginator_filters(t *testing.T) {
+	ctx := context.Background()
+	_, c := newFakeClient(t)
+
+	for _, category := range []string{"BLUEPRINT_COMPUTE", "BLUEPRINT_DATABASE", "BLUEPRINT_COMPUTE"} {
+		_, _, err := c.Blueprints.CreateBlueprint(ctx, &client.NewBlueprint{
+			CommonBlueprintFields: client.CommonBlueprintFields{
+				Name:       category,
+				Content:    "content",
+				Categories: []string{category},
+			},
+			Provider: "PROVIDER_AMAZON",
+		})
+		if err != nil {
+			t.Fatalf("creating blueprint: %v", err)
+		}
+	}
+
+	blueprints, err := c.Blueprints.ListBlueprintsPaginator(&client.BlueprintListOptions{
+		Categories: []string{"BLUEPRINT_COMPUTE"},
+	}).All(ctx)
+	if err != nil {
+		t.Fatalf("listing blueprints: %v", err)
+	}
+	if len(blueprints) != 2 {
+		t.Errorf("expected 2 compute blueprints, got %d", len(blueprints))
+	}
+}
+
+func TestPaginator_empty(t *testing.T) {
+	ctx := context.Background()
+	_, c := newFakeClient(t)
+
+	globalValues, err := c.GlobalValues.ListGlobalValuesPaginator(nil).All(ctx)
+	if err != nil {
+		t.Fatalf("listing global values: %v", err)
+	}
+	if len(globalValues) != 0 {
+		t.Errorf("expected no global values, got %d", len(globalValues))
+	}
+}
+
+// newPagesTestClient returns a client for an API that serves the pages
+// in order, whatever filter the request has.
+func newPagesTestClient[T any](t *testing.T, pages [][]T) *client.Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		w.Header().Set("Content-Type", client.MediaTypeJSON)
+		_ = json.NewEncoder(w).Encode(client.QueryResponse[T]{
+			Page:       page,
+			TotalPages: len(pages),
+			TotalItems: len(pages),
+			PageItems:  pages[page-1],
+		})
+	}))
+	t.Cleanup(server.Close)
+	return newTransportTestClient(t, server.URL, client.TransportConfig{})
+}
+
+func TestGetBlueprintByName_checksEveryPage(t *testing.T) {
+	// Like an API that ignores the name filter, or matches substrings
+	c := newPagesTestClient(t, [][]client.Blueprint{
+		{{SeriesId: "1", CommonBlueprintFields: client.CommonBlueprintFields{Name: "network-extra"}}},
+		{{SeriesId: "2", CommonBlueprintFields: client.CommonBlueprintFields{Name: "other"}}},
+		{{SeriesId: "3", CommonBlueprintFields: client.CommonBlueprintFields{Name: "network"}}},
+	})
+
+	blueprint, _, err := c.Blueprints.GetBlueprintByName(context.Background(), "network")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if blueprint == nil || blueprint.SeriesId != "3" {
+		t.Errorf("expected the blueprint named exactly network, got %+v", blueprint)
+	}
+
+	blueprint, _, err = c.Blueprints.GetBlueprintByName(context.Background(), "net")
+	if err != nil || blueprint != nil {
+		t.Errorf("expected no blueprint named net, got %+v, %v", blueprint, err)
+	}
+}
+
+func TestGetGlobalValueByKey_checksEveryPage(t *testing.T) {
+	c := newPagesTestClient(t, [][]client.GlobalValue{
+		{{SeriesId: "1", Key: "regions_extra"}, {SeriesId: "2", Key: "teams"}},
+		{{SeriesId: "3", Key: "regions"}},
+	})
+
+	globalValue, _, err := c.GlobalValues.GetGlobalValueByKey(context.Background(), "regions")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if globalValue == nil || globalValue.SeriesId != "3" {
+		t.Errorf("expected the global value with exactly the key regions, got %+v", globalValue)
+	}
+
+	globalValue, _, err = c.GlobalValues.GetGlobalValueByKey(context.Background(), "region")
+	if err != nil || globalValue != nil {
+		t.Errorf("expected no global value with the key region, got %+v, %v", globalValue, err)
+	}
+}
@@ -0,0 +1,123 @@
+package client
+
+import (
+	"context"
+	crand "crypto/rand"
+	"fmt"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+type retrySafeKey struct{}
+
+// withRetrySafety records in the request context whether the request
+// can be sent again without side effects. CheckRetry only sees the
+// context, not the request, when the transport fails.
+func withRetrySafety(ctx context.Context, req *http.Request) context.Context {
+	return context.WithValue(ctx, retrySafeKey{}, isRetrySafe(req))
+}
+
+// isRetrySafe reports whether sending req twice has the same effect as
+// sending it once. POSTs create a new entity each time, so they are
+// never retried blindly: not every Resourcely API version honors the
+// idempotency key they carry.
+func isRetrySafe(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// CheckRetry is the Resourcely retry policy for retryablehttp.
+//
+// Throttled requests (429) are always retried, because the API rejected
+// them without acting on them. Gateway errors (502, 503, 504) and
+// recoverable transport errors are retried only for requests that are
+// safe to repeat. Everything else is returned to the caller as is.
+func CheckRetry(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	if ctx.Err() != nil {
+		return false, ctx.Err()
+	}
+
+	safe, _ := ctx.Value(retrySafeKey{}).(bool)
+
+	if err != nil {
+		if !safe {
+			return false, err
+		}
+		return retryablehttp.DefaultRetryPolicy(ctx, resp, err)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true, nil
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return safe, nil
+	default:
+		return false, nil
+	}
+}
+
+// Backoff waits for as long as the Retry-After header of a 429 or 503
+// response asks, up to max. Otherwise it backs off exponentially from
+// min to max with jitter, so that parallel requests spread out.
+func Backoff(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
+	if resp != nil && (resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable) {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			if wait > max {
+				return max
+			}
+			return wait
+		}
+	}
+
+	wait := time.Duration(float64(min) * math.Pow(2, float64(attemptNum)))
+	if wait <= 0 || wait > max {
+		wait = max
+	}
+	// Equal jitter: wait at least half of the backoff, and a random
+	// amount of the other half.
+	half := wait / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// parseRetryAfter reads a Retry-After header given either in seconds or
+// as an HTTP date.
+func parseRetryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.ParseInt(header, 10, 64); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
+
+// NewIdempotencyKey returns a random version 4 UUID to send in the
+// Idempotency-Key header of a create.
+func NewIdempotencyKey() (string, error) {
+	var b [16]byte
+	if _, err := crand.Read(b[:]); err != nil {
+		return "", fmt.Errorf("generating idempotency key: %w", err)
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:]), nil
+}
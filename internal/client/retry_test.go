@@ -0,0 +1,140 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/client"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// newRetryTestClient returns a client for handler that retries twice
+// with the Resourcely retry policy, and a count of the requests made.
+func newRetryTestClient(t *testing.T, handler func(w http.ResponseWriter, r *http.Request, attempt int32)) (*client.Client, *int32) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler(w, r, atomic.AddInt32(&attempts, 1))
+	}))
+	t.Cleanup(server.Close)
+
+	httpClient := retryablehttp.NewClient()
+	httpClient.Logger = nil
+	httpClient.RetryMax = 2
+	httpClient.RetryWaitMin = time.Millisecond
+	httpClient.RetryWaitMax = 10 * time.Millisecond
+	httpClient.CheckRetry = client.CheckRetry
+	httpClient.Backoff = client.Backoff
+	httpClient.ErrorHandler = retryablehttp.PassthroughErrorHandler
+
+	c, err := client.NewClient(httpClient, server.URL, "token")
+	if err != nil {
+		t.Fatalf("creating client: %v", err)
+	}
+	return c, &attempts
+}
+
+func TestCheckRetry_retriesSafeRequestsOnGatewayErrors(t *testing.T) {
+	c, attempts := newRetryTestClient(t, func(w http.ResponseWriter, r *http.Request, attempt int32) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, _, err := c.Blueprints.GetBlueprintBySeriesId(context.Background(), "series-id")
+	if !client.IsServerError(err) {
+		t.Errorf("expected a server error, got %v", err)
+	}
+	if *attempts != 3 {
+		t.Errorf("expected 3 attempts, got %d", *attempts)
+	}
+}
+
+func TestCheckRetry_doesNotRetryPostsOnGatewayErrors(t *testing.T) {
+	c, attempts := newRetryTestClient(t, func(w http.ResponseWriter, r *http.Request, attempt int32) {
+		w.WriteHeader(http.StatusGatewayTimeout)
+	})
+
+	_, _, err := c.Blueprints.CreateBlueprint(context.Background(), &client.NewBlueprint{})
+	if !client.IsServerError(err) {
+		t.Errorf("expected a server error, got %v", err)
+	}
+	if *attempts != 1 {
+		t.Errorf("expected 1 attempt, got %d", *attempts)
+	}
+}
+
+func TestCheckRetry_retriesThrottledPosts(t *testing.T) {
+	var idempotencyKeys []string
+	c, attempts := newRetryTestClient(t, func(w http.ResponseWriter, r *http.Request, attempt int32) {
+		idempotencyKeys = append(idempotencyKeys, r.Header.Get(client.HeaderIdempotencyKey))
+		if attempt == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"series_id": "series-id"}`))
+	})
+
+	blueprint, _, err := c.Blueprints.CreateBlueprint(context.Background(), &client.NewBlueprint{})
+	if err != nil {
+		t.Fatalf("expected the throttled request to be retried, got %v", err)
+	}
+	if blueprint.SeriesId != "series-id" {
+		t.Errorf("unexpected blueprint %+v", blueprint)
+	}
+	if *attempts != 2 {
+		t.Errorf("expected 2 attempts, got %d", *attempts)
+	}
+	if len(idempotencyKeys) != 2 || idempotencyKeys[0] == "" || idempotencyKeys[0] != idempotencyKeys[1] {
+		t.Errorf("expected the retry to reuse the idempotency key, got %q", idempotencyKeys)
+	}
+}
+
+func TestCheckRetry_doesNotRetryServerErrors(t *testing.T) {
+	c, attempts := newRetryTestClient(t, func(w http.ResponseWriter, r *http.Request, attempt int32) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, _, err := c.Guardrails.GetGuardrailBySeriesId(context.Background(), "series-id")
+	if !client.IsServerError(err) {
+		t.Errorf("expected a server error, got %v", err)
+	}
+	if *attempts != 1 {
+		t.Errorf("expected 1 attempt, got %d", *attempts)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	throttled := func(retryAfter string) *http.Response {
+		resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+		if retryAfter != "" {
+			resp.Header.Set("Retry-After", retryAfter)
+		}
+		return resp
+	}
+
+	if wait := client.Backoff(time.Second, time.Minute, 0, throttled("3")); wait != 3*time.Second {
+		t.Errorf("expected to honor Retry-After seconds, got %s", wait)
+	}
+	if wait := client.Backoff(time.Second, 2*time.Second, 0, throttled("30")); wait != 2*time.Second {
+		t.Errorf("expected Retry-After to be capped at the max wait, got %s", wait)
+	}
+	date := time.Now().Add(10 * time.Second).UTC().Format(http.TimeFormat)
+	if wait := client.Backoff(time.Second, time.Minute, 0, throttled(date)); wait < 8*time.Second || wait > 10*time.Second {
+		t.Errorf("expected to honor a Retry-After date, got %s", wait)
+	}
+
+	for attempt := 0; attempt < 10; attempt++ {
+		wait := client.Backoff(time.Second, 8*time.Second, attempt, throttled(""))
+		ceiling := time.Second << attempt
+		if ceiling > 8*time.Second {
+			ceiling = 8 * time.Second
+		}
+		if wait < ceiling/2 || wait > ceiling {
+			t.Errorf("attempt %d: expected a wait between %s and %s, got %s", attempt, ceiling/2, ceiling, wait)
+		}
+	}
+}
@@ -10,7 +10,8 @@ import (
 type SystemService service
 
 type SystemHealth struct {
-	Status string `json:"status"`
+	Status     string `json:"status"`
+	AppVersion string `json:"app_version"`
 }
 
 func (s *SystemService) GetHealth(ctx context.Context) (*SystemHealth, *http.Response, error) {
@@ -0,0 +1,56 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracingInstrumentation = ProjectURL + "/internal/client"
+
+// startRequestSpan starts the span for a request to the Resourcely
+// API. It does nothing unless the provider has set up tracing.
+func (c *Client) startRequestSpan(ctx context.Context, req *http.Request) (context.Context, trace.Span) {
+	entityType, seriesId := entityFromPath(req.URL.Path, c.BasePath)
+
+	attributes := []attribute.KeyValue{
+		attribute.String("http.request.method", req.Method),
+		attribute.String("url.path", req.URL.Path),
+	}
+	if entityType != "" {
+		attributes = append(attributes, attribute.String("resourcely.entity_type", entityType))
+	}
+	if seriesId != "" {
+		attributes = append(attributes, attribute.String("resourcely.series_id", seriesId))
+	}
+
+	return otel.Tracer(tracingInstrumentation).Start(ctx, req.Method+" "+req.URL.Path,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attributes...),
+	)
+}
+
+// endRequestSpan records the outcome of the request and ends its span.
+func endRequestSpan(span trace.Span, resp *http.Response, err error) {
+	if resp != nil {
+		span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+		if requestId := resp.Header.Get(HeaderRequestId); requestId != "" {
+			span.SetAttributes(attribute.String("resourcely.request_id", requestId))
+		}
+	}
+
+	var errorResponse *ErrorResponse
+	if errors.As(err, &errorResponse) && errorResponse.Err.RequestId != "" {
+		span.SetAttributes(attribute.String("resourcely.request_id", errorResponse.Err.RequestId))
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,140 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+const (
+	DefaultRequestTimeout = 60 * time.Second
+	DefaultMaxRetries     = 4
+	DefaultRetryWaitMin   = 1 * time.Second
+	DefaultRetryWaitMax   = 30 * time.Second
+)
+
+// TransportConfig configures the HTTP client that talks to the
+// Resourcely API. The zero value uses the defaults.
+type TransportConfig struct {
+	// RequestTimeout bounds each attempt of a request, including
+	// reading the response body. Zero uses DefaultRequestTimeout.
+	RequestTimeout time.Duration
+
+	// MaxRetries is the number of times a request is retried after the
+	// first attempt. Nil uses DefaultMaxRetries.
+	MaxRetries *int
+
+	// RetryWaitMin and RetryWaitMax bound the wait between retries.
+	// Zero uses DefaultRetryWaitMin and DefaultRetryWaitMax.
+	RetryWaitMin time.Duration
+	RetryWaitMax time.Duration
+
+	// ProxyURL is the proxy for every request. Empty uses the
+	// HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables.
+	ProxyURL string
+
+	// CABundleFile is a PEM file of certificate authorities to trust in
+	// addition to the system ones, for example those of a
+	// TLS-intercepting proxy.
+	CABundleFile string
+
+	// ClientCertFile and ClientKeyFile are the PEM certificate and key
+	// to present for mutual TLS. Set both or neither.
+	ClientCertFile string
+	ClientKeyFile  string
+}
+
+// NewHTTPClient returns a retrying HTTP client for the Resourcely API
+// that follows the Resourcely retry policy.
+func NewHTTPClient(config TransportConfig) (*retryablehttp.Client, error) {
+	transport, err := newTransport(config)
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient := retryablehttp.NewClient()
+	httpClient.HTTPClient = &http.Client{
+		Transport: &loggingTransport{next: transport},
+		Timeout:   durationOr(config.RequestTimeout, DefaultRequestTimeout),
+	}
+	httpClient.RetryMax = DefaultMaxRetries
+	if config.MaxRetries != nil {
+		httpClient.RetryMax = *config.MaxRetries
+	}
+	httpClient.RetryWaitMin = durationOr(config.RetryWaitMin, DefaultRetryWaitMin)
+	httpClient.RetryWaitMax = durationOr(config.RetryWaitMax, DefaultRetryWaitMax)
+	if httpClient.RetryWaitMin > httpClient.RetryWaitMax {
+		return nil, fmt.Errorf("retry wait min %s is longer than retry wait max %s", httpClient.RetryWaitMin, httpClient.RetryWaitMax)
+	}
+
+	// Hand the last response to CheckResponse once retries run out, so
+	// the caller gets a typed error rather than a generic "giving up"
+	// error.
+	httpClient.ErrorHandler = retryablehttp.PassthroughErrorHandler
+	httpClient.CheckRetry = CheckRetry
+	httpClient.Backoff = Backoff
+	// Requests are logged through tflog by loggingTransport instead of
+	// printed to stderr.
+	httpClient.Logger = nil
+	return httpClient, nil
+}
+
+func newTransport(config TransportConfig) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if config.ProxyURL != "" {
+		proxyURL, err := url.Parse(config.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("parsing proxy URL: %w", err)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	if config.CABundleFile == "" && config.ClientCertFile == "" && config.ClientKeyFile == "" {
+		return transport, nil
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if config.CABundleFile != "" {
+		pem, err := os.ReadFile(config.CABundleFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading CA bundle: %w", err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("CA bundle %s contains no PEM certificates", config.CABundleFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if config.ClientCertFile != "" || config.ClientKeyFile != "" {
+		if config.ClientCertFile == "" || config.ClientKeyFile == "" {
+			return nil, fmt.Errorf("a client certificate and key must be set together")
+		}
+		cert, err := tls.LoadX509KeyPair(config.ClientCertFile, config.ClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("loading client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	transport.TLSClientConfig = tlsConfig
+	return transport, nil
+}
+
+func durationOr(d time.Duration, fallback time.Duration) time.Duration {
+	if d == 0 {
+		return fallback
+	}
+	return d
+}
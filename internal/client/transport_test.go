@@ -0,0 +1,159 @@
+package client_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/client"
+)
+
+func healthHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", client.MediaTypeJSON)
+	_, _ = w.Write([]byte(`{"status": "ok"}`))
+}
+
+// writePEM writes a PEM block of the given type to a file in a
+// temporary directory and returns its path.
+func writePEM(t *testing.T, name string, blockType string, der []byte) string {
+	file := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(file, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0o600); err != nil {
+		t.Fatalf("writing %s: %v", name, err)
+	}
+	return file
+}
+
+func newTransportTestClient(t *testing.T, url string, config client.TransportConfig) *client.Client {
+	httpClient, err := client.NewHTTPClient(config)
+	if err != nil {
+		t.Fatalf("creating HTTP client: %v", err)
+	}
+	httpClient.Logger = nil
+
+	c, err := client.NewClient(httpClient, url, "token")
+	if err != nil {
+		t.Fatalf("creating client: %v", err)
+	}
+	return c
+}
+
+func TestNewHTTPClient_caBundle(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(healthHandler))
+	t.Cleanup(server.Close)
+
+	noRetries := 0
+	untrusted := newTransportTestClient(t, server.URL, client.TransportConfig{MaxRetries: &noRetries})
+	if _, _, err := untrusted.System.GetHealth(context.Background()); err == nil {
+		t.Fatalf("expected the server certificate to be untrusted without the CA bundle")
+	}
+
+	caBundle := writePEM(t, "ca.pem", "CERTIFICATE", server.Certificate().Raw)
+	trusted := newTransportTestClient(t, server.URL, client.TransportConfig{CABundleFile: caBundle})
+	if _, _, err := trusted.System.GetHealth(context.Background()); err != nil {
+		t.Fatalf("expected the CA bundle to be trusted, got %v", err)
+	}
+}
+
+func TestNewHTTPClient_clientCertificate(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generating key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("creating certificate: %v", err)
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("marshaling key: %v", err)
+	}
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.TLS.PeerCertificates) == 0 || r.TLS.PeerCertificates[0].Subject.CommonName != "terraform" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		healthHandler(w, r)
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	c := newTransportTestClient(t, server.URL, client.TransportConfig{
+		CABundleFile:   writePEM(t, "ca.pem", "CERTIFICATE", server.Certificate().Raw),
+		ClientCertFile: writePEM(t, "client.pem", "CERTIFICATE", certDER),
+		ClientKeyFile:  writePEM(t, "client-key.pem", "PRIVATE KEY", keyDER),
+	})
+	if _, _, err := c.System.GetHealth(context.Background()); err != nil {
+		t.Fatalf("expected the client certificate to be presented, got %v", err)
+	}
+
+	if _, err := client.NewHTTPClient(client.TransportConfig{ClientCertFile: "client.pem"}); err == nil {
+		t.Errorf("expected an error for a client certificate without a key")
+	}
+}
+
+func TestNewHTTPClient_proxy(t *testing.T) {
+	var proxied int32
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&proxied, 1)
+		healthHandler(w, r)
+	}))
+	t.Cleanup(proxy.Close)
+
+	c := newTransportTestClient(t, "http://resourcely.invalid", client.TransportConfig{ProxyURL: proxy.URL})
+	if _, _, err := c.System.GetHealth(context.Background()); err != nil {
+		t.Fatalf("expected the request to go through the proxy, got %v", err)
+	}
+	if proxied != 1 {
+		t.Errorf("expected 1 proxied request, got %d", proxied)
+	}
+}
+
+func TestNewHTTPClient_timeoutAndRetries(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		time.Sleep(100 * time.Millisecond)
+		healthHandler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	retries := 1
+	c := newTransportTestClient(t, server.URL, client.TransportConfig{
+		RequestTimeout: 10 * time.Millisecond,
+		MaxRetries:     &retries,
+		RetryWaitMin:   time.Millisecond,
+		RetryWaitMax:   time.Millisecond,
+	})
+	if _, _, err := c.System.GetHealth(context.Background()); err == nil {
+		t.Fatalf("expected the request to time out")
+	}
+	if attempts != 2 {
+		t.Errorf("expected 2 attempts, got %d", attempts)
+	}
+
+	if _, err := client.NewHTTPClient(client.TransportConfig{RetryWaitMin: time.Minute, RetryWaitMax: time.Second}); err == nil {
+		t.Errorf("expected an error for a retry wait min longer than the max")
+	}
+}
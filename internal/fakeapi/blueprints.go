@@ -0,0 +1,154 @@
+package fakeapi
+
+import (
+	"net/http"
+
+	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/client"
+)
+
+func (s *Server) registerBlueprints(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/blueprints/series/{seriesId}", s.getBlueprint)
+	mux.HandleFunc("GET /api/v1/blueprints", s.listBlueprints)
+	mux.HandleFunc("POST /api/v1/blueprints", s.createBlueprint)
+	mux.HandleFunc("PUT /api/v1/blueprints/series/{seriesId}", s.updateBlueprint)
+	mux.HandleFunc("PATCH /api/v1/blueprints/series/{seriesId}", s.patchBlueprint)
+	mux.HandleFunc("DELETE /api/v1/blueprints/series/{seriesId}", s.deleteBlueprint)
+}
+
+func (s *Server) getBlueprint(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	blueprint, ok := s.blueprints.latest(r.PathValue("seriesId"))
+	if !ok {
+		writeError(w, r, http.StatusNotFound, "blueprint not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, blueprint)
+}
+
+func (s *Server) listBlueprints(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	query := r.URL.Query()
+	blueprints := s.blueprints.list(func(blueprint client.Blueprint) bool {
+		labels := make([]string, len(blueprint.Labels))
+		for i, label := range blueprint.Labels {
+			labels[i] = label.Label
+		}
+
+		return matches(query, "name", blueprint.Name) &&
+			matches(query, "provider", blueprint.Provider) &&
+			matchesAny(query, "category", blueprint.Categories) &&
+			matchesAny(query, "label", labels) &&
+			matchesBool(query, "is_published", blueprint.IsPublished)
+	})
+	sortItems(r, blueprints)
+	writeJSON(w, http.StatusOK, paginate(r, blueprints))
+}
+
+func (s *Server) createBlueprint(w http.ResponseWriter, r *http.Request) {
+	var newBlueprint client.NewBlueprint
+	if !readJSON(w, r, &newBlueprint) {
+		return
+	}
+	if errors := validateBlueprint(newBlueprint.CommonBlueprintFields, newBlueprint.Provider); len(errors) > 0 {
+		writeError(w, r, http.StatusBadRequest, errors...)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	blueprint := s.blueprints.create(client.Blueprint{
+		Scope:                 "SCOPE_TENANT",
+		CommonBlueprintFields: withLabelIds(newBlueprint.CommonBlueprintFields),
+		Provider:              newBlueprint.Provider,
+		IsPublished:           newBlueprint.IsPublished,
+	})
+	writeJSON(w, http.StatusOK, blueprint)
+}
+
+func (s *Server) updateBlueprint(w http.ResponseWriter, r *http.Request) {
+	var updatedBlueprint client.UpdatedBlueprint
+	if !readJSON(w, r, &updatedBlueprint) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	seriesId := r.PathValue("seriesId")
+	current, ok := s.blueprints.latest(seriesId)
+	if !ok {
+		writeError(w, r, http.StatusNotFound, "blueprint not found")
+		return
+	}
+	if !s.ifMatch(w, r, current.Version) {
+		return
+	}
+	if errors := validateBlueprint(updatedBlueprint.CommonBlueprintFields, current.Provider); len(errors) > 0 {
+		writeError(w, r, http.StatusBadRequest, errors...)
+		return
+	}
+
+	current.CommonBlueprintFields = withLabelIds(updatedBlueprint.CommonBlueprintFields)
+	blueprint, _ := s.blueprints.update(seriesId, current)
+	writeJSON(w, http.StatusOK, blueprint)
+}
+
+// patchBlueprint changes the publication status of the current
+// version. Unlike an update, a patch does not create a new version.
+func (s *Server) patchBlueprint(w http.ResponseWriter, r *http.Request) {
+	var patchedBlueprint client.PatchedBlueprint
+	if !readJSON(w, r, &patchedBlueprint) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	seriesId := r.PathValue("seriesId")
+	blueprint, ok := s.blueprints.latest(seriesId)
+	if !ok {
+		writeError(w, r, http.StatusNotFound, "blueprint not found")
+		return
+	}
+	if !s.ifMatch(w, r, blueprint.Version) {
+		return
+	}
+
+	blueprint.IsPublished = patchedBlueprint.IsPublished
+	s.blueprints.replace(seriesId, blueprint)
+	writeJSON(w, http.StatusOK, blueprint)
+}
+
+func (s *Server) deleteBlueprint(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.blueprints.delete(r.PathValue("seriesId")) {
+		writeError(w, r, http.StatusNotFound, "blueprint not found")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func validateBlueprint(fields client.CommonBlueprintFields, provider string) []string {
+	return required(map[string]string{
+		"name":     fields.Name,
+		"content":  fields.Content,
+		"provider": provider,
+	})
+}
+
+// withLabelIds assigns an id to every label, as the real API does.
+func withLabelIds(fields client.CommonBlueprintFields) client.CommonBlueprintFields {
+	labels := make([]client.Label, len(fields.Labels))
+	for i, label := range fields.Labels {
+		labels[i] = client.Label{Id: newUUID(), Label: label.Label}
+	}
+	fields.Labels = labels
+	return fields
+}
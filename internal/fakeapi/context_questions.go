@@ -0,0 +1,124 @@
+package fakeapi
+
+import (
+	"net/http"
+	"regexp"
+
+	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/client"
+)
+
+func (s *Server) registerContextQuestions(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/context-questions/series/{seriesId}", s.getContextQuestion)
+	mux.HandleFunc("GET /api/v1/context-questions", s.listContextQuestions)
+	mux.HandleFunc("POST /api/v1/context-questions", s.createContextQuestion)
+	mux.HandleFunc("PUT /api/v1/context-questions/series/{seriesId}", s.updateContextQuestion)
+	mux.HandleFunc("DELETE /api/v1/context-questions/series/{seriesId}", s.deleteContextQuestion)
+}
+
+func (s *Server) getContextQuestion(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	contextQuestion, ok := s.contextQuestions.latest(r.PathValue("seriesId"))
+	if !ok {
+		writeError(w, r, http.StatusNotFound, "context question not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, contextQuestion)
+}
+
+func (s *Server) listContextQuestions(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	query := r.URL.Query()
+	contextQuestions := s.contextQuestions.list(func(contextQuestion client.ContextQuestion) bool {
+		return matches(query, "label", contextQuestion.Label) &&
+			matches(query, "qtype", contextQuestion.Qtype) &&
+			matches(query, "scope", contextQuestion.Scope) &&
+			matchesAny(query, "blueprint_category", contextQuestion.BlueprintCategories)
+	})
+	sortItems(r, contextQuestions)
+	writeJSON(w, http.StatusOK, paginate(r, contextQuestions))
+}
+
+func (s *Server) createContextQuestion(w http.ResponseWriter, r *http.Request) {
+	var newContextQuestion client.NewContextQuestion
+	if !readJSON(w, r, &newContextQuestion) {
+		return
+	}
+	if errors := validateContextQuestion(newContextQuestion.CommonContextQuestionFields); len(errors) > 0 {
+		writeError(w, r, http.StatusBadRequest, errors...)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fields := newContextQuestion.CommonContextQuestionFields
+	for _, existing := range s.contextQuestions.list(nil) {
+		if existing.Label == fields.Label {
+			writeError(w, r, http.StatusConflict, "label: a context question with this label already exists")
+			return
+		}
+	}
+
+	contextQuestion := s.contextQuestions.create(client.ContextQuestion{
+		CommonContextQuestionFields: fields,
+	})
+	writeJSON(w, http.StatusOK, contextQuestion)
+}
+
+func (s *Server) updateContextQuestion(w http.ResponseWriter, r *http.Request) {
+	var updatedContextQuestion client.UpdatedContextQuestion
+	if !readJSON(w, r, &updatedContextQuestion) {
+		return
+	}
+	if errors := validateContextQuestion(updatedContextQuestion.CommonContextQuestionFields); len(errors) > 0 {
+		writeError(w, r, http.StatusBadRequest, errors...)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	seriesId := r.PathValue("seriesId")
+	current, ok := s.contextQuestions.latest(seriesId)
+	if !ok {
+		writeError(w, r, http.StatusNotFound, "context question not found")
+		return
+	}
+	if !s.ifMatch(w, r, current.Version) {
+		return
+	}
+
+	current.CommonContextQuestionFields = updatedContextQuestion.CommonContextQuestionFields
+	contextQuestion, _ := s.contextQuestions.update(seriesId, current)
+	writeJSON(w, http.StatusOK, contextQuestion)
+}
+
+func (s *Server) deleteContextQuestion(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.contextQuestions.delete(r.PathValue("seriesId")) {
+		writeError(w, r, http.StatusNotFound, "context question not found")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func validateContextQuestion(fields client.CommonContextQuestionFields) []string {
+	errors := required(map[string]string{
+		"label":  fields.Label,
+		"prompt": fields.Prompt,
+		"qtype":  fields.Qtype,
+		"scope":  fields.Scope,
+	})
+	if fields.AnswerFormat == "ANSWER_REGEX" {
+		if _, err := regexp.Compile(fields.RegexPattern); err != nil {
+			errors = append(errors, "regex_pattern: is not a valid regular expression")
+		}
+	}
+	return errors
+}
@@ -0,0 +1,137 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/client"
+)
+
+// Global values are called presets in the API. Like the real API, the
+// fake does not support deleting them.
+func (s *Server) registerGlobalValues(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/presets/series/{seriesId}", s.getGlobalValue)
+	mux.HandleFunc("GET /api/v1/presets", s.listGlobalValues)
+	mux.HandleFunc("POST /api/v1/presets", s.createGlobalValue)
+	mux.HandleFunc("PUT /api/v1/presets/series/{seriesId}", s.updateGlobalValue)
+}
+
+func (s *Server) getGlobalValue(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	globalValue, ok := s.globalValues.latest(r.PathValue("seriesId"))
+	if !ok {
+		writeError(w, r, http.StatusNotFound, "preset not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, globalValue)
+}
+
+func (s *Server) listGlobalValues(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	query := r.URL.Query()
+	globalValues := s.globalValues.list(func(globalValue client.GlobalValue) bool {
+		return matches(query, "key", globalValue.Key) &&
+			matches(query, "name", globalValue.Name) &&
+			matches(query, "type", globalValue.Type) &&
+			matchesBool(query, "is_deprecated", globalValue.IsDeprecated)
+	})
+	sortItems(r, globalValues)
+	writeJSON(w, http.StatusOK, paginate(r, globalValues))
+}
+
+func (s *Server) createGlobalValue(w http.ResponseWriter, r *http.Request) {
+	var newGlobalValue client.NewGlobalValue
+	if !readJSON(w, r, &newGlobalValue) {
+		return
+	}
+	errors := required(map[string]string{
+		"key":  newGlobalValue.Key,
+		"name": newGlobalValue.Name,
+		"type": newGlobalValue.Type,
+	})
+	errors = append(errors, validateOptions(newGlobalValue.Type, newGlobalValue.Options)...)
+	if len(errors) > 0 {
+		writeError(w, r, http.StatusBadRequest, errors...)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, existing := range s.globalValues.list(nil) {
+		if existing.Key == newGlobalValue.Key {
+			writeError(w, r, http.StatusConflict, "key: a preset with this key already exists")
+			return
+		}
+	}
+
+	globalValue := s.globalValues.create(client.GlobalValue{
+		CommonGlobalValueFields: newGlobalValue.CommonGlobalValueFields,
+		Key:                     newGlobalValue.Key,
+		Type:                    newGlobalValue.Type,
+		IsDeprecated:            newGlobalValue.IsDeprecated,
+	})
+	writeJSON(w, http.StatusOK, globalValue)
+}
+
+func (s *Server) updateGlobalValue(w http.ResponseWriter, r *http.Request) {
+	var updatedGlobalValue client.UpdatedGlobalValue
+	if !readJSON(w, r, &updatedGlobalValue) {
+		return
+	}
+	if errors := required(map[string]string{"name": updatedGlobalValue.Name}); len(errors) > 0 {
+		writeError(w, r, http.StatusBadRequest, errors...)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	seriesId := r.PathValue("seriesId")
+	current, ok := s.globalValues.latest(seriesId)
+	if !ok {
+		writeError(w, r, http.StatusNotFound, "preset not found")
+		return
+	}
+	if !s.ifMatch(w, r, current.Version) {
+		return
+	}
+	if errors := validateOptions(current.Type, updatedGlobalValue.Options); len(errors) > 0 {
+		writeError(w, r, http.StatusBadRequest, errors...)
+		return
+	}
+
+	current.CommonGlobalValueFields = updatedGlobalValue.CommonGlobalValueFields
+	current.IsDeprecated = updatedGlobalValue.IsDeprecated
+	globalValue, _ := s.globalValues.update(seriesId, current)
+	writeJSON(w, http.StatusOK, globalValue)
+}
+
+// validateOptions checks that each option value has the JSON type the
+// preset type calls for.
+func validateOptions(presetType string, options []client.GlobalValueOption) []string {
+	var errors []string
+	for i, option := range options {
+		var ok bool
+		switch presetType {
+		case "PRESET_VALUE_TEXT":
+			_, ok = option.Value.(string)
+		case "PRESET_VALUE_NUMBER":
+			_, ok = option.Value.(float64)
+		case "PRESET_VALUE_LIST":
+			_, ok = option.Value.([]interface{})
+		case "PRESET_VALUE_OBJECT":
+			_, ok = option.Value.(map[string]interface{})
+		default:
+			ok = true
+		}
+		if !ok {
+			errors = append(errors, fmt.Sprintf("options[%d].value: does not match preset type %s", i, presetType))
+		}
+	}
+	return errors
+}
@@ -0,0 +1,119 @@
+package fakeapi
+
+import (
+	"net/http"
+
+	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/client"
+)
+
+func (s *Server) registerGuardrails(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/guardrails/series/{seriesId}", s.getGuardrail)
+	mux.HandleFunc("GET /api/v1/guardrails", s.listGuardrails)
+	mux.HandleFunc("POST /api/v1/guardrails", s.createGuardrail)
+	mux.HandleFunc("PUT /api/v1/guardrails/series/{seriesId}", s.updateGuardrail)
+	mux.HandleFunc("DELETE /api/v1/guardrails/series/{seriesId}", s.deleteGuardrail)
+}
+
+func (s *Server) getGuardrail(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	guardrail, ok := s.guardrails.latest(r.PathValue("seriesId"))
+	if !ok {
+		writeError(w, r, http.StatusNotFound, "guardrail not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, guardrail)
+}
+
+func (s *Server) listGuardrails(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	query := r.URL.Query()
+	guardrails := s.guardrails.list(func(guardrail client.Guardrail) bool {
+		return matches(query, "name", guardrail.Name) &&
+			matches(query, "provider", guardrail.Provider) &&
+			matchesAny(query, "category", []string{guardrail.Category}) &&
+			matchesAny(query, "state", []string{guardrail.State})
+	})
+	sortItems(r, guardrails)
+	writeJSON(w, http.StatusOK, paginate(r, guardrails))
+}
+
+func (s *Server) createGuardrail(w http.ResponseWriter, r *http.Request) {
+	var newGuardrail client.NewGuardrail
+	if !readJSON(w, r, &newGuardrail) {
+		return
+	}
+	if errors := validateGuardrail(newGuardrail.CommonGuardrailFields, newGuardrail.GuardrailTemplateSeriesId); len(errors) > 0 {
+		writeError(w, r, http.StatusBadRequest, errors...)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	guardrail := client.Guardrail{
+		Scope:                   "SCOPE_TENANT",
+		CommonGuardrailFields:   newGuardrail.CommonGuardrailFields,
+		GuardrailTemplateInputs: newGuardrail.GuardrailTemplateInputs,
+	}
+	guardrail.GuardrailTemplate.SeriesId = newGuardrail.GuardrailTemplateSeriesId
+
+	writeJSON(w, http.StatusOK, s.guardrails.create(guardrail))
+}
+
+func (s *Server) updateGuardrail(w http.ResponseWriter, r *http.Request) {
+	var updatedGuardrail client.UpdatedGuardrail
+	if !readJSON(w, r, &updatedGuardrail) {
+		return
+	}
+	if errors := validateGuardrail(updatedGuardrail.CommonGuardrailFields, updatedGuardrail.GuardrailTemplateSeriesId); len(errors) > 0 {
+		writeError(w, r, http.StatusBadRequest, errors...)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	seriesId := r.PathValue("seriesId")
+	current, ok := s.guardrails.latest(seriesId)
+	if !ok {
+		writeError(w, r, http.StatusNotFound, "guardrail not found")
+		return
+	}
+	if !s.ifMatch(w, r, current.Version) {
+		return
+	}
+
+	current.CommonGuardrailFields = updatedGuardrail.CommonGuardrailFields
+	current.GuardrailTemplate.SeriesId = updatedGuardrail.GuardrailTemplateSeriesId
+	current.GuardrailTemplateInputs = updatedGuardrail.GuardrailTemplateInputs
+
+	guardrail, _ := s.guardrails.update(seriesId, current)
+	writeJSON(w, http.StatusOK, guardrail)
+}
+
+func (s *Server) deleteGuardrail(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.guardrails.delete(r.PathValue("seriesId")) {
+		writeError(w, r, http.StatusNotFound, "guardrail not found")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func validateGuardrail(fields client.CommonGuardrailFields, templateSeriesId string) []string {
+	errors := required(map[string]string{
+		"name":     fields.Name,
+		"provider": fields.Provider,
+		"category": fields.Category,
+	})
+	if fields.Content == "" && templateSeriesId == "" {
+		errors = append(errors, "content: must not be empty")
+	}
+	return errors
+}
@@ -0,0 +1,426 @@
+// Package fakeapi implements an in-process, stateful fake of the
+// Resourcely API.
+//
+// The fake serves the same paths that the services in the client
+// package call, so the provider's acceptance tests can run without a
+// live Resourcely tenant. It keeps every entity as a versioned series,
+// just like the real API: creating an entity starts a new series at
+// version 1, and every update appends a new version to the series.
+package fakeapi
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/client"
+)
+
+const (
+	DefaultTenant     = "fake-tenant"
+	DefaultSubject    = "fake-user@example.com"
+	DefaultAppVersion = "fake"
+
+	signingKey = "resourcely-fake-api"
+)
+
+// Server is a fake Resourcely API backed by an httptest.Server.
+type Server struct {
+	*httptest.Server
+
+	// Token is the only auth token the server accepts.
+	Token string
+
+	// IgnoreIfMatch makes the server apply updates whatever version
+	// their If-Match header names, like an API that does not support
+	// it. Set it before sending any request.
+	IgnoreIfMatch bool
+
+	mu sync.Mutex
+
+	requestCount int
+
+	blueprints       *store[client.Blueprint]
+	guardrails       *store[client.Guardrail]
+	contextQuestions *store[client.ContextQuestion]
+	globalValues     *store[client.GlobalValue]
+
+	idempotentResponses map[string]*httptest.ResponseRecorder
+	lostResponses       map[string]int
+	requestHooks        map[string][]func()
+	failures            map[string][]failure
+}
+
+// failure is an error response for a request that FailNextRequest
+// rejects.
+type failure struct {
+	status  int
+	message string
+}
+
+// NewServer starts a fake Resourcely API server with no entities. The
+// caller must call Close when done.
+func NewServer() *Server {
+	s := &Server{
+		Token: NewToken(DefaultTenant, time.Now().Add(30*24*time.Hour)),
+
+		blueprints: newStore(func(b *client.Blueprint) header {
+			return header{&b.Id, &b.SeriesId, &b.Version, &b.UpdatedBy}
+		}),
+		guardrails: newStore(func(g *client.Guardrail) header {
+			return header{&g.Id, &g.SeriesId, &g.Version, &g.UpdatedBy}
+		}),
+		contextQuestions: newStore(func(cq *client.ContextQuestion) header {
+			return header{&cq.Id, &cq.SeriesId, &cq.Version, &cq.UpdatedBy}
+		}),
+		globalValues: newStore(func(gv *client.GlobalValue) header {
+			return header{&gv.Id, &gv.SeriesId, &gv.Version, &gv.UpdatedBy}
+		}),
+
+		idempotentResponses: map[string]*httptest.ResponseRecorder{},
+		lostResponses:       map[string]int{},
+		requestHooks:        map[string][]func(){},
+		failures:            map[string][]failure{},
+	}
+
+	mux := http.NewServeMux()
+	s.registerSystem(mux)
+	s.registerBlueprints(mux)
+	s.registerGuardrails(mux)
+	s.registerContextQuestions(mux)
+	s.registerGlobalValues(mux)
+
+	s.Server = httptest.NewServer(s.authenticate(s.runHooks(s.failRequests(s.loseResponses(s.idempotent(mux))))))
+	return s
+}
+
+// NewToken returns a signed auth token carrying the given tenant
+// claim. The fake server does not verify the signature.
+func NewToken(tenant string, expiresAt time.Time) string {
+	claims := client.ResourcelyClaims{
+		Tenant: tenant,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   DefaultSubject,
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(signingKey))
+	if err != nil {
+		panic(fmt.Sprintf("fakeapi: signing token: %v", err))
+	}
+	return token
+}
+
+// authenticate rejects any request that does not carry the server's
+// token, mirroring the real API's bare 401 responses.
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.requestCount++
+		requestId := fmt.Sprintf("fake-request-%d", s.requestCount)
+		s.mu.Unlock()
+
+		w.Header().Set(client.HeaderRequestId, requestId)
+
+		if r.Header.Get(client.HeaderToken) != fmt.Sprintf(client.HeaderTokenFormat, s.Token) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// BeforeNextRequest runs hook just before the server handles the next
+// request with the given method, for example to change an entity
+// between a Terraform plan and apply. The hook may call the server.
+func (s *Server) BeforeNextRequest(method string, hook func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requestHooks[method] = append(s.requestHooks[method], hook)
+}
+
+func (s *Server) runHooks(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var hook func()
+		s.mu.Lock()
+		if hooks := s.requestHooks[r.Method]; len(hooks) > 0 {
+			hook, s.requestHooks[r.Method] = hooks[0], hooks[1:]
+		}
+		s.mu.Unlock()
+
+		if hook != nil {
+			hook()
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// FailNextRequest makes the server reject the next request with the
+// given method with an error response, without handling it, for
+// example to fail the second step of an update.
+func (s *Server) FailNextRequest(method string, status int, message string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures[method] = append(s.failures[method], failure{status, message})
+}
+
+func (s *Server) failRequests(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var f *failure
+		s.mu.Lock()
+		if failures := s.failures[r.Method]; len(failures) > 0 {
+			f, s.failures[r.Method] = &failures[0], failures[1:]
+		}
+		s.mu.Unlock()
+
+		if f != nil {
+			writeError(w, r, f.status, f.message)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// LoseNextResponse makes the server handle the next request with the
+// given method as usual, but answer it with a 504 Gateway Timeout, as
+// if a proxy gave up waiting after the change was committed.
+func (s *Server) LoseNextResponse(method string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lostResponses[method]++
+}
+
+func (s *Server) loseResponses(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		lose := s.lostResponses[r.Method] > 0
+		if lose {
+			s.lostResponses[r.Method]--
+		}
+		s.mu.Unlock()
+
+		if !lose {
+			next.ServeHTTP(w, r)
+			return
+		}
+		next.ServeHTTP(httptest.NewRecorder(), r)
+		writeError(w, r, http.StatusGatewayTimeout, "upstream request timeout")
+	})
+}
+
+// idempotent replays the recorded response when a POST is sent again
+// with the same idempotency key, instead of creating another entity.
+func (s *Server) idempotent(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(client.HeaderIdempotencyKey)
+		if r.Method != http.MethodPost || key == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		key = r.URL.Path + " " + key
+
+		s.mu.Lock()
+		recorded, ok := s.idempotentResponses[key]
+		s.mu.Unlock()
+
+		if !ok {
+			recorded = httptest.NewRecorder()
+			next.ServeHTTP(recorded, r)
+
+			s.mu.Lock()
+			s.idempotentResponses[key] = recorded
+			s.mu.Unlock()
+		}
+
+		for name, values := range recorded.Header() {
+			if name != client.HeaderRequestId {
+				w.Header()[name] = values
+			}
+		}
+		w.WriteHeader(recorded.Code)
+		_, _ = w.Write(recorded.Body.Bytes())
+	})
+}
+
+// writeJSON writes v as the JSON response body with the given status.
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", client.MediaTypeJSON)
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error response in the API's standard format.
+func writeError(w http.ResponseWriter, r *http.Request, status int, errors ...string) {
+	writeJSON(w, status, client.Err{
+		Status:      uint32(status),
+		RequestId:   w.Header().Get(client.HeaderRequestId),
+		Errors:      errors,
+		RequestPath: r.URL.Path,
+		AppVersion:  DefaultAppVersion,
+	})
+}
+
+// ifMatch writes a 412 response unless the request's If-Match header,
+// if it has one, names the current version of the series, or the
+// server ignores If-Match.
+func (s *Server) ifMatch(w http.ResponseWriter, r *http.Request, current int64) bool {
+	expected := r.Header.Get(client.HeaderIfMatch)
+	if s.IgnoreIfMatch || expected == "" || expected == strconv.FormatInt(current, 10) {
+		return true
+	}
+	writeError(w, r, http.StatusPreconditionFailed,
+		fmt.Sprintf("version mismatch: expected version %s, but the current version is %d", expected, current))
+	return false
+}
+
+// readJSON decodes the request body into v, writing a 400 response
+// if the body is not valid JSON.
+func readJSON(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, r, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return false
+	}
+	return true
+}
+
+// page is the API's standard envelope for list responses.
+type page[T any] struct {
+	Page       int `json:"page,omitempty"`
+	PageSize   int `json:"page_size,omitempty"`
+	TotalPages int `json:"total_pages,omitempty"`
+	TotalItems int `json:"total_items"`
+
+	PageItems []T `json:"page_items"`
+}
+
+// paginate slices items according to the page and page_size query
+// parameters. Pages are numbered from 1.
+func paginate[T any](r *http.Request, items []T) page[T] {
+	pageNumber, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if pageNumber < 1 {
+		pageNumber = 1
+	}
+	pageSize, _ := strconv.Atoi(r.URL.Query().Get("page_size"))
+	if pageSize < 1 {
+		pageSize = 20
+	}
+
+	totalPages := (len(items) + pageSize - 1) / pageSize
+	start := min((pageNumber-1)*pageSize, len(items))
+	end := min(start+pageSize, len(items))
+
+	return page[T]{
+		Page:       pageNumber,
+		PageSize:   pageSize,
+		TotalPages: totalPages,
+		TotalItems: len(items),
+		PageItems:  append([]T{}, items[start:end]...),
+	}
+}
+
+// sortItems orders items by the JSON field named in the sort_field
+// query parameter, honoring sort_order=desc.
+func sortItems[T any](r *http.Request, items []T) {
+	field := r.URL.Query().Get("sort_field")
+	if field == "" {
+		return
+	}
+	descending := r.URL.Query().Get("sort_order") == "desc"
+
+	keys := make([]interface{}, len(items))
+	indexes := make([]int, len(items))
+	for i, item := range items {
+		indexes[i] = i
+
+		var fields map[string]interface{}
+		data, _ := json.Marshal(item)
+		_ = json.Unmarshal(data, &fields)
+		keys[i] = fields[field]
+	}
+
+	less := func(a, b interface{}) bool {
+		if x, ok := a.(float64); ok {
+			if y, ok := b.(float64); ok {
+				return x < y
+			}
+		}
+		return fmt.Sprint(a) < fmt.Sprint(b)
+	}
+	sort.SliceStable(indexes, func(i, j int) bool {
+		if descending {
+			return less(keys[indexes[j]], keys[indexes[i]])
+		}
+		return less(keys[indexes[i]], keys[indexes[j]])
+	})
+
+	sorted := make([]T, len(items))
+	for i, index := range indexes {
+		sorted[i] = items[index]
+	}
+	copy(items, sorted)
+}
+
+// matches reports whether value satisfies a single-valued filter. An
+// absent filter matches everything.
+func matches(query url.Values, key string, value string) bool {
+	filter := query.Get(key)
+	return filter == "" || filter == value
+}
+
+// matchesAny reports whether any of values satisfies a multi-valued
+// filter. An absent filter matches everything.
+func matchesAny(query url.Values, key string, values []string) bool {
+	filters := query[key]
+	if len(filters) == 0 {
+		return true
+	}
+	for _, value := range values {
+		if slices.Contains(filters, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesBool reports whether value satisfies a boolean filter. An
+// absent filter matches everything.
+func matchesBool(query url.Values, key string, value bool) bool {
+	filter := query.Get(key)
+	return filter == "" || filter == strconv.FormatBool(value)
+}
+
+// newUUID returns a random version 4 UUID.
+func newUUID() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic(fmt.Sprintf("fakeapi: generating uuid: %v", err))
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// required returns an error message for each named field whose value
+// is empty.
+func required(fields map[string]string) []string {
+	var errors []string
+	for name, value := range fields {
+		if strings.TrimSpace(value) == "" {
+			errors = append(errors, name+": must not be empty")
+		}
+	}
+	return errors
+}
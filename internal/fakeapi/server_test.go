@@ -0,0 +1,231 @@
+package fakeapi
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/client"
+)
+
+func newTestClient(t *testing.T) (*Server, *client.Client) {
+	server := NewServer()
+	t.Cleanup(server.Close)
+
+	c, err := client.NewClient(nil, server.URL, server.Token)
+	if err != nil {
+		t.Fatalf("creating client: %v", err)
+	}
+	return server, c
+}
+
+func TestServer_rejectsUnknownToken(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	c, err := client.NewClient(nil, server.URL, "not-the-token")
+	if err != nil {
+		t.Fatalf("creating client: %v", err)
+	}
+
+	_, resp, err := c.System.GetHealth(context.Background())
+	if err == nil || resp == nil || resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected 401, got resp=%v err=%v", resp, err)
+	}
+}
+
+func TestServer_blueprintVersions(t *testing.T) {
+	ctx := context.Background()
+	_, c := newTestClient(t)
+
+	created, _, err := c.Blueprints.CreateBlueprint(ctx, &client.NewBlueprint{
+		CommonBlueprintFields: client.CommonBlueprintFields{Name: "one", Content: "content"},
+		Provider:              "PROVIDER_AMAZON",
+	})
+	if err != nil {
+		t.Fatalf("creating blueprint: %v", err)
+	}
+	if created.Version != 1 {
+		t.Errorf("expected version 1, got %d", created.Version)
+	}
+
+	updated, _, err := c.Blueprints.UpdateBlueprint(ctx, &client.UpdatedBlueprint{
+		SeriesId:              created.SeriesId,
+		CommonBlueprintFields: client.CommonBlueprintFields{Name: "two", Content: "content"},
+	})
+	if err != nil {
+		t.Fatalf("updating blueprint: %v", err)
+	}
+	if updated.SeriesId != created.SeriesId || updated.Id == created.Id || updated.Version != 2 {
+		t.Errorf("expected a new version of the same series, got %+v", updated)
+	}
+
+	patched, _, err := c.Blueprints.PatchBlueprint(ctx, &client.PatchedBlueprint{SeriesId: created.SeriesId, IsPublished: true})
+	if err != nil {
+		t.Fatalf("patching blueprint: %v", err)
+	}
+	if !patched.IsPublished || patched.Version != 2 {
+		t.Errorf("expected a published blueprint at version 2, got %+v", patched)
+	}
+
+	if _, err := c.Blueprints.DeleteBlueprint(ctx, created.SeriesId); err != nil {
+		t.Fatalf("deleting blueprint: %v", err)
+	}
+	_, resp, err := c.Blueprints.GetBlueprintBySeriesId(ctx, created.SeriesId)
+	if err == nil || resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected 404 after delete, got resp=%v err=%v", resp, err)
+	}
+}
+
+func TestServer_globalValueByKey(t *testing.T) {
+	ctx := context.Background()
+	_, c := newTestClient(t)
+
+	for _, key := range []string{"first", "second"} {
+		_, _, err := c.GlobalValues.CreateGlobalValue(ctx, &client.NewGlobalValue{
+			CommonGlobalValueFields: client.CommonGlobalValueFields{Name: key},
+			Key:                     key,
+			Type:                    "PRESET_VALUE_TEXT",
+		})
+		if err != nil {
+			t.Fatalf("creating global value %s: %v", key, err)
+		}
+	}
+
+	globalValue, _, err := c.GlobalValues.GetGlobalValueByKey(ctx, "second")
+	if err != nil {
+		t.Fatalf("getting global value by key: %v", err)
+	}
+	if globalValue == nil || globalValue.Key != "second" {
+		t.Fatalf("expected global value with key second, got %+v", globalValue)
+	}
+
+	_, resp, err := c.GlobalValues.CreateGlobalValue(ctx, &client.NewGlobalValue{
+		CommonGlobalValueFields: client.CommonGlobalValueFields{Name: "again"},
+		Key:                     "first",
+		Type:                    "PRESET_VALUE_TEXT",
+	})
+	if err == nil || resp.StatusCode != http.StatusConflict {
+		t.Fatalf("expected 409 for a duplicate key, got resp=%v err=%v", resp, err)
+	}
+}
+
+func TestServer_idempotentCreates(t *testing.T) {
+	ctx := context.Background()
+	_, c := newTestClient(t)
+
+	create := func() *client.Guardrail {
+		req, err := c.NewRequest(http.MethodPost, "api/v1/guardrails", &client.NewGuardrail{
+			CommonGuardrailFields: client.CommonGuardrailFields{Name: "once", Provider: "PROVIDER_AMAZON", Category: "GUARDRAIL_BEST_PRACTICES", State: "GUARDRAIL_STATE_ACTIVE", Content: "content"},
+		})
+		if err != nil {
+			t.Fatalf("building request: %v", err)
+		}
+		req.Header.Set(client.HeaderIdempotencyKey, "key")
+
+		guardrail := new(client.Guardrail)
+		if _, err := c.Do(ctx, req, guardrail); err != nil {
+			t.Fatalf("creating guardrail: %v", err)
+		}
+		return guardrail
+	}
+
+	first, second := create(), create()
+	if first.SeriesId != second.SeriesId {
+		t.Errorf("expected a retried create to return the same guardrail, got %s and %s", first.SeriesId, second.SeriesId)
+	}
+
+	page, _, err := c.Guardrails.ListGuardrails(ctx, nil)
+	if err != nil {
+		t.Fatalf("listing guardrails: %v", err)
+	}
+	if page.TotalItems != 1 {
+		t.Errorf("expected 1 guardrail, got %d", page.TotalItems)
+	}
+}
+
+func TestServer_loseNextResponse(t *testing.T) {
+	ctx := context.Background()
+	server, c := newTestClient(t)
+
+	server.LoseNextResponse(http.MethodPost)
+	_, _, err := c.GlobalValues.CreateGlobalValue(ctx, &client.NewGlobalValue{
+		CommonGlobalValueFields: client.CommonGlobalValueFields{Name: "lost"},
+		Key:                     "lost",
+		Type:                    "PRESET_VALUE_TEXT",
+	})
+	if !client.IsServerError(err) {
+		t.Fatalf("expected a gateway timeout, got %v", err)
+	}
+
+	globalValue, _, err := c.GlobalValues.GetGlobalValueByKey(ctx, "lost")
+	if err != nil || globalValue == nil {
+		t.Fatalf("expected the global value to be committed, got %+v, %v", globalValue, err)
+	}
+}
+
+func TestServer_failNextRequest(t *testing.T) {
+	ctx := context.Background()
+	server, c := newTestClient(t)
+
+	created, _, err := c.Blueprints.CreateBlueprint(ctx, &client.NewBlueprint{
+		CommonBlueprintFields: client.CommonBlueprintFields{Name: "failed", Content: "content"},
+		Provider:              "PROVIDER_AMAZON",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server.FailNextRequest(http.MethodPatch, http.StatusForbidden, "publishing is not allowed")
+	_, _, err = c.Blueprints.PatchBlueprint(ctx, &client.PatchedBlueprint{SeriesId: created.SeriesId, IsPublished: true})
+	if !client.IsForbidden(err) {
+		t.Fatalf("expected a forbidden error, got %v", err)
+	}
+
+	blueprint, _, err := c.Blueprints.GetBlueprintBySeriesId(ctx, created.SeriesId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if blueprint.IsPublished {
+		t.Errorf("expected the rejected patch not to be applied")
+	}
+
+	// Only the next request fails
+	if _, _, err := c.Blueprints.PatchBlueprint(ctx, &client.PatchedBlueprint{SeriesId: created.SeriesId, IsPublished: true}); err != nil {
+		t.Errorf("expected the second patch to succeed, got %v", err)
+	}
+}
+
+func TestServer_beforeNextRequest(t *testing.T) {
+	ctx := context.Background()
+	server, c := newTestClient(t)
+
+	created, _, err := c.GlobalValues.CreateGlobalValue(ctx, &client.NewGlobalValue{
+		CommonGlobalValueFields: client.CommonGlobalValueFields{Name: "hooked"},
+		Key:                     "hooked",
+		Type:                    "PRESET_VALUE_TEXT",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The hook's own update runs before the update that triggered it.
+	server.BeforeNextRequest(http.MethodPut, func() {
+		_, _, err := c.GlobalValues.UpdateGlobalValue(ctx, &client.UpdatedGlobalValue{
+			SeriesId:                created.SeriesId,
+			CommonGlobalValueFields: client.CommonGlobalValueFields{Name: "hook"},
+		})
+		if err != nil {
+			t.Errorf("updating from the hook: %v", err)
+		}
+	})
+
+	_, _, err = c.GlobalValues.UpdateGlobalValue(ctx, &client.UpdatedGlobalValue{
+		SeriesId:                created.SeriesId,
+		Version:                 created.Version,
+		CommonGlobalValueFields: client.CommonGlobalValueFields{Name: "stale"},
+	})
+	if !client.IsVersionMismatch(err) {
+		t.Fatalf("expected a version mismatch, got %v", err)
+	}
+}
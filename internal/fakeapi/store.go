@@ -0,0 +1,98 @@
+package fakeapi
+
+// header points at the identifying fields shared by every versioned
+// entity.
+type header struct {
+	Id        *string
+	SeriesId  *string
+	Version   *int64
+	UpdatedBy *string
+}
+
+// store holds every version of every series of one entity type. It is
+// not safe for concurrent use; the Server serializes access.
+type store[T any] struct {
+	header func(*T) header
+
+	series map[string][]T
+	order  []string
+}
+
+func newStore[T any](header func(*T) header) *store[T] {
+	return &store[T]{header: header, series: map[string][]T{}}
+}
+
+// create starts a new series at version 1.
+func (s *store[T]) create(entity T) T {
+	h := s.header(&entity)
+	*h.Id = newUUID()
+	*h.SeriesId = newUUID()
+	*h.Version = 1
+	*h.UpdatedBy = DefaultSubject
+
+	s.series[*h.SeriesId] = []T{entity}
+	s.order = append(s.order, *h.SeriesId)
+	return entity
+}
+
+// latest returns the current version of a series.
+func (s *store[T]) latest(seriesId string) (T, bool) {
+	versions, ok := s.series[seriesId]
+	if !ok {
+		var zero T
+		return zero, false
+	}
+	return versions[len(versions)-1], true
+}
+
+// update appends a new version to a series.
+func (s *store[T]) update(seriesId string, entity T) (T, bool) {
+	current, ok := s.latest(seriesId)
+	if !ok {
+		return entity, false
+	}
+
+	h := s.header(&entity)
+	*h.Id = newUUID()
+	*h.SeriesId = seriesId
+	*h.Version = *s.header(&current).Version + 1
+	*h.UpdatedBy = DefaultSubject
+
+	s.series[seriesId] = append(s.series[seriesId], entity)
+	return entity, true
+}
+
+// replace overwrites the current version of a series in place,
+// without creating a new version.
+func (s *store[T]) replace(seriesId string, entity T) {
+	versions := s.series[seriesId]
+	versions[len(versions)-1] = entity
+}
+
+// delete removes a series and all of its versions.
+func (s *store[T]) delete(seriesId string) bool {
+	if _, ok := s.series[seriesId]; !ok {
+		return false
+	}
+	delete(s.series, seriesId)
+	for i, id := range s.order {
+		if id == seriesId {
+			s.order = append(s.order[:i], s.order[i+1:]...)
+			break
+		}
+	}
+	return true
+}
+
+// list returns the current version of every series in creation order
+// that satisfies the filter.
+func (s *store[T]) list(filter func(T) bool) []T {
+	entities := make([]T, 0, len(s.order))
+	for _, seriesId := range s.order {
+		entity, _ := s.latest(seriesId)
+		if filter == nil || filter(entity) {
+			entities = append(entities, entity)
+		}
+	}
+	return entities
+}
@@ -0,0 +1,15 @@
+package fakeapi
+
+import (
+	"net/http"
+
+	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/client"
+)
+
+func (s *Server) registerSystem(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/system/health", s.getHealth)
+}
+
+func (s *Server) getHealth(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, client.SystemHealth{Status: "ok", AppVersion: DefaultAppVersion})
+}
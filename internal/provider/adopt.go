@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/client"
+)
+
+// adoptLookupTimeout bounds the lookup for a created entity, which may
+// run after the create itself timed out.
+const adoptLookupTimeout = 30 * time.Second
+
+// createMayHaveSucceeded reports whether the API may have committed a
+// create that failed: the request was sent, but the connection broke,
+// it timed out or the API answered with a server error. Errors raised
+// before the request was sent, such as an expired token or read-only
+// mode, and other API errors mean that nothing was created.
+func createMayHaveSucceeded(err error) bool {
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+	var errorResponse *client.ErrorResponse
+	if errors.As(err, &errorResponse) {
+		return client.IsServerError(err)
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var urlError *url.Error
+	if errors.As(err, &urlError) {
+		return true
+	}
+	var netError net.Error
+	return errors.As(err, &netError)
+}
+
+// adoptCreated looks for the entity that a failed create may have
+// committed anyway, so that Terraform records it instead of leaving an
+// orphan behind. It only looks once a create has failed in a way that
+// may have committed, so creates that succeed cost nothing extra.
+//
+// find looks the entity up by its name, label or key. It returns nil
+// if there is no such entity, and fails if there is more than one.
+// version returns the version of the entity found, and matches reports
+// whether it is the one that was sent. Only the first version of a
+// series is adopted: an entity that has been updated since was not
+// created by the failed request.
+//
+// adoptCreated returns nil if the create certainly failed or no
+// matching entity was found.
+func adoptCreated[T any](
+	ctx context.Context,
+	createErr error,
+	find func(context.Context) (*T, error),
+	version func(*T) int64,
+	matches func(*T) bool,
+) *T {
+	if !createMayHaveSucceeded(createErr) {
+		return nil
+	}
+
+	lookupCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), adoptLookupTimeout)
+	defer cancel()
+
+	entity, err := find(lookupCtx)
+	if err != nil {
+		tflog.Warn(ctx, "Could not check whether the failed create was committed", map[string]any{"error": err.Error()})
+		return nil
+	}
+	if entity == nil || version(entity) != 1 || !matches(entity) {
+		return nil
+	}
+	return entity
+}
+
+// adoptedWarning tells the user that a create failed but was committed,
+// and that Terraform now manages the committed entity.
+func adoptedWarning(kind string, seriesId string, createErr error) diag.Diagnostic {
+	return diag.NewWarningDiagnostic(
+		"Adopted "+kind+" "+seriesId+" after a failed create",
+		"Creating the "+kind+" failed with: "+createErr.Error()+"\n\n"+
+			"The Resourcely API had committed the "+kind+" anyway, so Terraform now manages it instead of creating a duplicate.",
+	)
+}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/client"
+)
+
+func TestCreateMayHaveSucceeded(t *testing.T) {
+	errorResponse := func(status int) error {
+		return &client.ErrorResponse{Response: &http.Response{StatusCode: status}}
+	}
+	transportError := &url.Error{Op: "Post", URL: "https://api.resourcely.io/api/v1/guardrails", Err: errors.New("connection reset by peer")}
+
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"server error", errorResponse(http.StatusInternalServerError), true},
+		{"gateway timeout", errorResponse(http.StatusGatewayTimeout), true},
+		{"transport error", transportError, true},
+		{"wrapped transport error", fmt.Errorf("giving up: %w", transportError), true},
+		{"timeout", context.DeadlineExceeded, true},
+		{"validation error", errorResponse(http.StatusBadRequest), false},
+		{"conflict", errorResponse(http.StatusConflict), false},
+		{"canceled", context.Canceled, false},
+		{"expired token", &client.TokenExpiredError{ExpiresAt: time.Now()}, false},
+		{"read-only", &client.ReadOnlyError{Method: http.MethodPost, Path: "/api/v1/guardrails"}, false},
+		{"encoding error", errors.New("json: unsupported type: chan int"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := createMayHaveSucceeded(tt.err); got != tt.want {
+				t.Errorf("createMayHaveSucceeded() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
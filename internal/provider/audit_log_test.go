@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/matoous/go-nanoid/v2"
+)
+
+func TestAccProvider_auditLog(t *testing.T) {
+	id := gonanoid.MustGenerate("abcdefghijklmnopqrstuvwxyz", 16)
+	auditLogPath := filepath.Join(t.TempDir(), "audit.jsonl")
+	testAccPreCheck(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig_auditLogDeniedTenant(auditLogPath, id),
+				ExpectError: regexp.MustCompile("Resourcely tenant not allowed"),
+			},
+			{
+				// The rejected provider left no audit log behind
+				PreConfig: func() {
+					if _, err := os.Stat(auditLogPath); !os.IsNotExist(err) {
+						t.Fatalf("expected no audit log for a rejected tenant, got %v", err)
+					}
+				},
+				Config:      testAccProviderConfig_auditLog(filepath.Join(auditLogPath, "missing"), id),
+				ExpectError: regexp.MustCompile("Opening Resourcely Audit Log Failed"),
+			},
+			{
+				Config: testAccProviderConfig_auditLog(auditLogPath, id),
+				Check: func(s *terraform.State) error {
+					data, err := os.ReadFile(auditLogPath)
+					if err != nil {
+						return err
+					}
+					lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+					if len(lines) != 1 {
+						return fmt.Errorf("expected one audit record for the create, got %d", len(lines))
+					}
+					for _, want := range []string{`"method":"POST"`, `"entity_type":"global_value"`, `"version_after":1`} {
+						if !strings.Contains(lines[0], want) {
+							return fmt.Errorf("expected %s in the audit record %s", want, lines[0])
+						}
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func testAccProviderConfig_auditLog(auditLogPath string, id string) string {
+	return fmt.Sprintf(`
+provider "resourcely" {
+  host           = %q
+  audit_log_path = %q
+}
+
+resource "resourcely_global_value" "basic" {
+  key  = "audit_log_%s"
+  name = "Audit Log %s"
+  type = "PRESET_VALUE_TEXT"
+  options = [
+    {
+      key   = "option_0"
+      label = "Option 0"
+      value = jsonencode("option_0_value")
+    },
+  ]
+}
+`, os.Getenv(hostnameVar), auditLogPath, id, id)
+}
+
+func testAccProviderConfig_auditLogDeniedTenant(auditLogPath string, id string) string {
+	return strings.Replace(testAccProviderConfig_auditLog(auditLogPath, id),
+		"audit_log_path = ", "denied_tenants = [\"*\"]\n  audit_log_path = ", 1)
+}
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/client"
+)
+
+const (
+	tokenExpiryWarningVar = "RESOURCELY_TOKEN_EXPIRY_WARNING"
+
+	// defaultTokenExpiryWarning is how long before its expiry the
+	// provider starts warning that the auth token expires soon.
+	defaultTokenExpiryWarning = 7 * 24 * time.Hour
+
+	// tokenClockSkew tolerates clocks that are slightly ahead of or
+	// behind the Resourcely API when checking nbf.
+	tokenClockSkew = time.Minute
+)
+
+// validateAuthToken checks the expiry, not-before and subject claims of
+// the auth token before any API call, so that an expired token is
+// reported with its expiry time rather than as a bare 401. It warns if
+// the token expires within warningWindow.
+func validateAuthToken(authToken string, now time.Time, warningWindow time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if authToken == "" {
+		diags.AddError(
+			"Missing Resourcely Auth Token",
+			"Set auth_token, auth_token_file, credential_process or profile in the provider block, "+
+				"or the RESOURCELY_AUTH_TOKEN environment variable.",
+		)
+		return diags
+	}
+
+	// Only JWTs carry claims to check. The API decides whether any other
+	// token is valid.
+	claims, err := client.ParseClaims(authToken)
+	if err != nil {
+		return diags
+	}
+
+	if claims.Subject == "" {
+		diags.AddWarning(
+			"Resourcely Auth Token Has No Subject",
+			"The auth token has no sub claim, so the Resourcely API may not accept it. Generate a new token from the Resourcely Settings page.",
+		)
+	}
+
+	// A token without an exp claim does not expire.
+	if claims.ExpiresAt != nil && !now.Before(claims.ExpiresAt.Time) {
+		expiresAt := claims.ExpiresAt.Time
+		diags.AddError(
+			"Resourcely Auth Token Expired",
+			fmt.Sprintf(
+				"The auth token expired at %s, %s ago. Generate a new token from the Resourcely Settings page.",
+				formatTokenTime(expiresAt), now.Sub(expiresAt).Round(time.Second),
+			),
+		)
+		return diags
+	}
+
+	if claims.NotBefore != nil && now.Add(tokenClockSkew).Before(claims.NotBefore.Time) {
+		diags.AddError(
+			"Resourcely Auth Token Not Yet Valid",
+			fmt.Sprintf("The auth token is not valid until %s. Check that the system clock is correct.", formatTokenTime(claims.NotBefore.Time)),
+		)
+		return diags
+	}
+
+	if claims.ExpiresAt == nil {
+		return diags
+	}
+	expiresAt := claims.ExpiresAt.Time
+	if remaining := expiresAt.Sub(now); remaining < warningWindow {
+		diags.AddWarning(
+			"Resourcely Auth Token Expires Soon",
+			fmt.Sprintf(
+				"The auth token expires at %s, in %s. Requests made after that fail, so a long apply may not finish. Generate a new token from the Resourcely Settings page.",
+				formatTokenTime(expiresAt), remaining.Round(time.Second),
+			),
+		)
+	}
+
+	return diags
+}
+
+func formatTokenTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
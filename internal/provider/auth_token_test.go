@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/client"
+	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/fakeapi"
+)
+
+func testAuthToken(t *testing.T, claims jwt.RegisteredClaims) string {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, client.ResourcelyClaims{
+		Tenant:           fakeapi.DefaultTenant,
+		RegisteredClaims: claims,
+	}).SignedString([]byte("test"))
+	if err != nil {
+		t.Fatalf("signing token: %v", err)
+	}
+	return token
+}
+
+func TestValidateAuthToken(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	window := 24 * time.Hour
+
+	tests := []struct {
+		name    string
+		token   string
+		summary string
+		detail  string
+		isError bool
+	}{
+		{
+			name:  "valid",
+			token: testAuthToken(t, jwt.RegisteredClaims{Subject: "user", ExpiresAt: jwt.NewNumericDate(now.Add(30 * 24 * time.Hour))}),
+		},
+		{
+			name:    "missing",
+			token:   "",
+			summary: "Missing Resourcely Auth Token",
+			isError: true,
+		},
+		{
+			name:  "not a JWT",
+			token: "not-a-jwt",
+		},
+		{
+			name:  "no expiry",
+			token: testAuthToken(t, jwt.RegisteredClaims{Subject: "user"}),
+		},
+		{
+			name: "no expiry not yet valid",
+			token: testAuthToken(t, jwt.RegisteredClaims{
+				Subject:   "user",
+				NotBefore: jwt.NewNumericDate(now.Add(time.Hour)),
+			}),
+			summary: "Resourcely Auth Token Not Yet Valid",
+			detail:  "not valid until 2024-06-01T13:00:00Z",
+			isError: true,
+		},
+		{
+			name:    "no subject",
+			token:   testAuthToken(t, jwt.RegisteredClaims{ExpiresAt: jwt.NewNumericDate(now.Add(30 * 24 * time.Hour))}),
+			summary: "Resourcely Auth Token Has No Subject",
+			detail:  "no sub claim",
+		},
+		{
+			name:    "expired",
+			token:   testAuthToken(t, jwt.RegisteredClaims{Subject: "user", ExpiresAt: jwt.NewNumericDate(now.Add(-90 * time.Minute))}),
+			summary: "Resourcely Auth Token Expired",
+			detail:  "expired at 2024-06-01T10:30:00Z, 1h30m0s ago",
+			isError: true,
+		},
+		{
+			name: "not yet valid",
+			token: testAuthToken(t, jwt.RegisteredClaims{
+				Subject:   "user",
+				NotBefore: jwt.NewNumericDate(now.Add(time.Hour)),
+				ExpiresAt: jwt.NewNumericDate(now.Add(30 * 24 * time.Hour)),
+			}),
+			summary: "Resourcely Auth Token Not Yet Valid",
+			detail:  "not valid until 2024-06-01T13:00:00Z",
+			isError: true,
+		},
+		{
+			name: "not yet valid within clock skew",
+			token: testAuthToken(t, jwt.RegisteredClaims{
+				Subject:   "user",
+				NotBefore: jwt.NewNumericDate(now.Add(30 * time.Second)),
+				ExpiresAt: jwt.NewNumericDate(now.Add(30 * 24 * time.Hour)),
+			}),
+		},
+		{
+			name:    "expires soon",
+			token:   testAuthToken(t, jwt.RegisteredClaims{Subject: "user", ExpiresAt: jwt.NewNumericDate(now.Add(2 * time.Hour))}),
+			summary: "Resourcely Auth Token Expires Soon",
+			detail:  "expires at 2024-06-01T14:00:00Z, in 2h0m0s",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			diags := validateAuthToken(test.token, now, window)
+			if test.summary == "" {
+				if len(diags) > 0 {
+					t.Fatalf("expected no diagnostics, got %v", diags)
+				}
+				return
+			}
+
+			if len(diags) != 1 {
+				t.Fatalf("expected 1 diagnostic, got %v", diags)
+			}
+			if diags.HasError() != test.isError {
+				t.Errorf("expected error %t, got %v", test.isError, diags)
+			}
+			if diags[0].Summary() != test.summary || !strings.Contains(diags[0].Detail(), test.detail) {
+				t.Errorf("expected %q containing %q, got %q: %q", test.summary, test.detail, diags[0].Summary(), diags[0].Detail())
+			}
+		})
+	}
+}
+
+func TestAccProvider_expiredAuthToken(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccUseFakeAPI(t)
+			t.Setenv(authTokenVar, fakeapi.NewToken(fakeapi.DefaultTenant, time.Now().Add(-time.Hour)))
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      `data "resourcely_global_values" "all" {}`,
+				ExpectError: regexp.MustCompile("Resourcely Auth Token Expired"),
+			},
+		},
+	})
+}
+
+func TestAccProvider_opaqueAuthToken(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			server := testAccUseFakeAPI(t)
+			server.Token = "opaque-token"
+			t.Setenv(authTokenVar, server.Token)
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "resourcely_global_values" "all" {}`,
+				Check:  resource.TestCheckResourceAttr("data.resourcely_global_values.all", "id", "global_values"),
+			},
+		},
+	})
+}
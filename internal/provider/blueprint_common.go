@@ -63,3 +63,8 @@ func FlattenBlueprint(blueprint *client.Blueprint) BlueprintResourceModel {
 
 	return data
 }
+
+// blueprintAPIFields maps blueprint API fields to their schema attributes.
+var blueprintAPIFields = map[string]string{
+	"provider": "cloud_provider",
+}
@@ -7,12 +7,17 @@ import (
 	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
-var _ datasource.DataSource = &BlueprintDataSource{}
+var (
+	_ datasource.DataSource                     = &BlueprintDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &BlueprintDataSource{}
+)
 
 func NewBlueprintDataSource() datasource.DataSource {
 	return &BlueprintDataSource{}
@@ -37,8 +42,9 @@ func (d *BlueprintDataSource) Schema(_ context.Context, _ datasource.SchemaReque
 				Computed:            true,
 			},
 			"series_id": schema.StringAttribute{
-				MarkdownDescription: "UUID for the blueprint",
-				Required:            true,
+				MarkdownDescription: "UUID for the blueprint. Exactly one of `series_id` or `name` must be set.",
+				Optional:            true,
+				Computed:            true,
 			},
 			"version": schema.Int64Attribute{
 				MarkdownDescription: "Increment version number for the current version of the blueprint.",
@@ -48,7 +54,8 @@ func (d *BlueprintDataSource) Schema(_ context.Context, _ datasource.SchemaReque
 				Computed: true,
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the blueprint.",
+				MarkdownDescription: "The name of the blueprint. Exactly one of `series_id` or `name` must be set. Fails unless exactly one blueprint has this name.",
+				Optional:            true,
 				Computed:            true,
 			},
 			"description": schema.StringAttribute{
@@ -110,7 +117,21 @@ func (d *BlueprintDataSource) Configure(_ context.Context, req datasource.Config
 	d.service = client.Blueprints
 }
 
+func (d *BlueprintDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("series_id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
 func (d *BlueprintDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.service == nil {
+		resp.Diagnostics.Append(unknownConfigDiagnostic())
+		return
+	}
+
 	// Read the config
 	var config BlueprintResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
@@ -118,15 +139,39 @@ func (d *BlueprintDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
-	blueprintSeriesId := config.SeriesId.ValueString()
-
-	blueprint, _, err := d.service.GetBlueprintBySeriesId(ctx, blueprintSeriesId)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error reading blueprint",
-			"Could not read blueprint series id "+blueprintSeriesId+": "+err.Error(),
-		)
-		return
+	var blueprint *client.Blueprint
+	var err error
+	if !config.SeriesId.IsNull() {
+		blueprintSeriesId := config.SeriesId.ValueString()
+
+		blueprint, _, err = d.service.GetBlueprintBySeriesId(ctx, blueprintSeriesId)
+		if err != nil {
+			resp.Diagnostics.Append(apiErrorDiagnostic(
+				"Error reading blueprint",
+				"Could not read blueprint series id "+blueprintSeriesId+": "+err.Error(),
+				err,
+			))
+			return
+		}
+	} else {
+		blueprintName := config.Name.ValueString()
+
+		blueprint, _, err = d.service.GetBlueprintByName(ctx, blueprintName)
+		if err != nil {
+			resp.Diagnostics.Append(apiErrorDiagnostic(
+				"Error reading blueprint",
+				"Could not read blueprint name "+blueprintName+": "+err.Error(),
+				err,
+			))
+			return
+		}
+		if blueprint == nil {
+			resp.Diagnostics.AddError(
+				"Blueprint not found",
+				"No blueprint has the name "+blueprintName+".",
+			)
+			return
+		}
 	}
 
 	// Overwrite state with refreshed value
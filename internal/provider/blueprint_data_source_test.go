@@ -74,3 +74,80 @@ data "resourcely_blueprint" "by_series_id" {
 }
 `, contextQuestionLabel)
 }
+
+func TestAccBlueprintDataSource_byName(t *testing.T) {
+	name := "basic_test_" + acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccBlueprintDataSourceConfig_byName(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.resourcely_blueprint.by_name", "series_id", "resourcely_blueprint.basic", "series_id"),
+					resource.TestCheckResourceAttrPair("data.resourcely_blueprint.by_name", "id", "resourcely_blueprint.basic", "id"),
+					resource.TestCheckResourceAttr("data.resourcely_blueprint.by_name", "name", name),
+					resource.TestCheckResourceAttr("data.resourcely_blueprint.by_name", "cloud_provider", "PROVIDER_AMAZON"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBlueprintDataSourceConfig_byName(name string) string {
+	return fmt.Sprintf(`
+resource "resourcely_blueprint" "basic" {
+  name = "%s"
+  cloud_provider = "PROVIDER_AMAZON"
+  categories = ["BLUEPRINT_BLOB_STORAGE"]
+  content = <<-EOT
+              resource "aws_s3_bucket" "{{ resource_name }}" {
+                bucket = "{{ bucket }}"
+              }
+            EOT
+}
+
+data "resourcely_blueprint" "by_name" {
+  name = resourcely_blueprint.basic.name
+}
+`, name)
+}
+
+func TestAccBlueprintDataSource_errorsNotFound(t *testing.T) {
+	name := "missing_" + acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               ErrorCheckExpectedErrorMessagesContaining(t, "No blueprint has the name "+name),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "resourcely_blueprint" "by_name" {
+  name = "%s"
+}
+`, name),
+			},
+		},
+	})
+}
+
+func TestAccBlueprintDataSource_errorsSeriesIdAndName(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               ErrorCheckExpectedErrorMessagesContaining(t, "Exactly one of these attributes must be configured"),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "resourcely_blueprint" "both" {
+  series_id = "00000000-0000-0000-0000-000000000000"
+  name      = "basic_test"
+}
+`,
+			},
+		},
+	})
+}
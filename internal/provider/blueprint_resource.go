@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"net/http"
 
 	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
@@ -11,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -26,6 +26,7 @@ import (
 var (
 	_ resource.Resource                = &BlueprintResource{}
 	_ resource.ResourceWithImportState = &BlueprintResource{}
+	_ resource.ResourceWithModifyPlan  = &BlueprintResource{}
 )
 
 func NewBlueprintResource() resource.Resource {
@@ -207,6 +208,9 @@ func (r *BlueprintResource) Create(
 	req resource.CreateRequest,
 	resp *resource.CreateResponse,
 ) {
+	ctx, span := startResourceSpan(ctx, "resourcely_blueprint", "Create")
+	defer endResourceSpan(ctx, span, &resp.State, &resp.Diagnostics)
+
 	// Get the plan
 	var plan BlueprintResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -224,10 +228,28 @@ func (r *BlueprintResource) Create(
 
 	blueprint, _, err := r.service.CreateBlueprint(ctx, newBlueprint)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		blueprint = adoptCreated(ctx, err,
+			func(ctx context.Context) (*client.Blueprint, error) {
+				blueprint, _, err := r.service.GetBlueprintByName(ctx, newBlueprint.Name)
+				return blueprint, err
+			},
+			func(blueprint *client.Blueprint) int64 { return blueprint.Version },
+			func(blueprint *client.Blueprint) bool {
+				return blueprint.Provider == newBlueprint.Provider && blueprint.Content == newBlueprint.Content
+			},
+		)
+		if blueprint != nil {
+			resp.Diagnostics.Append(adoptedWarning("blueprint", blueprint.SeriesId, err))
+			err = nil
+		}
+	}
+	if err != nil {
+		resp.Diagnostics.Append(apiPlanErrorDiagnostics(
+			ctx, req.Plan, blueprintAPIFields,
 			"Error creating blueprint",
 			"Could not create blueprint: "+err.Error(),
-		)
+			err,
+		)...)
 		return
 	}
 
@@ -241,6 +263,13 @@ func (r *BlueprintResource) Read(
 	req resource.ReadRequest,
 	resp *resource.ReadResponse,
 ) {
+	ctx, span := startResourceSpan(ctx, "resourcely_blueprint", "Read")
+	defer endResourceSpan(ctx, span, &resp.State, &resp.Diagnostics)
+
+	if awaitingConfig(r.service) {
+		return
+	}
+
 	// Get the current state
 	var state BlueprintResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -249,12 +278,12 @@ func (r *BlueprintResource) Read(
 	}
 
 	// Refresh value from the remote API
-	blueprint, httpResp, err := r.service.GetBlueprintBySeriesId(
+	blueprint, _, err := r.service.GetBlueprintBySeriesId(
 		ctx,
 		state.SeriesId.ValueString(),
 	)
 	if err != nil {
-		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+		if client.IsNotFound(err) {
 			resp.Diagnostics.AddWarning(
 				"Blueprint "+state.SeriesId.ValueString()+" was not found in Resourcely",
 				"The blueprint may have been deleted outside of Terraform",
@@ -262,16 +291,21 @@ func (r *BlueprintResource) Read(
 			resp.State.RemoveResource(ctx)
 			return
 		} else {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(apiErrorDiagnostic(
 				"Error reading blueprint",
 				"Could not read blueprint series id "+state.SeriesId.ValueString()+": "+err.Error(),
-			)
+				err,
+			))
 			return
 		}
 	}
 
 	// Overwrite state with refreshed value
-	state = FlattenBlueprint(blueprint)
+	current := FlattenBlueprint(blueprint)
+	if versionChanged(state.Version, blueprint.Version) {
+		resp.Diagnostics.Append(driftDiagnostic("blueprint", blueprint.SeriesId, state.Version.ValueInt64(), blueprint.Version, blueprint.UpdatedBy, changedAttributes(state, current)))
+	}
+	state = current
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -280,6 +314,9 @@ func (r *BlueprintResource) Update(
 	req resource.UpdateRequest,
 	resp *resource.UpdateResponse,
 ) {
+	ctx, span := startResourceSpan(ctx, "resourcely_blueprint", "Update")
+	defer endResourceSpan(ctx, span, &resp.State, &resp.Diagnostics)
+
 	// Retrieve the plan and state
 	var plan BlueprintResourceModel
 	var state BlueprintResourceModel
@@ -289,6 +326,20 @@ func (r *BlueprintResource) Update(
 		return
 	}
 
+	resp.Diagnostics.Append(checkVersion(ctx, "blueprint", state.SeriesId.ValueString(), state.Version,
+		func(ctx context.Context) (*client.Blueprint, error) {
+			blueprint, _, err := r.service.GetBlueprintBySeriesId(ctx, state.SeriesId.ValueString())
+			return blueprint, err
+		},
+		func(blueprint *client.Blueprint) int64 { return blueprint.Version },
+		func(blueprint *client.Blueprint) []string {
+			return changedAttributes(state, FlattenBlueprint(blueprint))
+		},
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Compute which update methods needs to be called.
 	//
 	// Some fields are changed via update (PUT) and some are changed
@@ -301,31 +352,54 @@ func (r *BlueprintResource) Update(
 	if needsUpdate {
 		updatedBlueprint := &client.UpdatedBlueprint{
 			SeriesId:              state.SeriesId.ValueString(),
+			Version:               state.Version.ValueInt64(),
 			CommonBlueprintFields: r.buildCommonFields(ctx, plan),
 		}
 
 		blueprint, _, err = r.service.UpdateBlueprint(ctx, updatedBlueprint)
+		if client.IsVersionMismatch(err) {
+			resp.Diagnostics.Append(r.versionConflict(ctx, state, updatedBlueprint.Version, err))
+			return
+		}
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(apiPlanErrorDiagnostics(
+				ctx, req.Plan, blueprintAPIFields,
 				"Error updating blueprint",
 				"Could not put blueprint series id "+state.SeriesId.ValueString()+": "+err.Error(),
-			)
+				err,
+			)...)
 			return
 		}
+
+		// Record the new version now, so that it is not lost if the
+		// patch fails. The next plan then only retries the patch.
+		updated := FlattenBlueprint(blueprint)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &updated)...)
 	}
 
 	// Patch the resource
 	if needsPatch {
 		patchedBlueprint := &client.PatchedBlueprint{
 			SeriesId:    state.SeriesId.ValueString(),
+			Version:     state.Version.ValueInt64(),
 			IsPublished: plan.IsPublished.ValueBool(),
 		}
+		if blueprint != nil {
+			// The update created a new version
+			patchedBlueprint.Version = blueprint.Version
+		}
 		blueprint, _, err = r.service.PatchBlueprint(ctx, patchedBlueprint)
+		if client.IsVersionMismatch(err) {
+			resp.Diagnostics.Append(r.versionConflict(ctx, state, patchedBlueprint.Version, err))
+			return
+		}
 		if err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(apiPlanErrorDiagnostics(
+				ctx, req.Plan, blueprintAPIFields,
 				"Error updating blueprint",
 				"Could not patch blueprint series id "+state.SeriesId.ValueString()+": "+err.Error(),
-			)
+				err,
+			)...)
 			return
 		}
 	}
@@ -340,6 +414,9 @@ func (r *BlueprintResource) Delete(
 	req resource.DeleteRequest,
 	resp *resource.DeleteResponse,
 ) {
+	ctx, span := startResourceSpan(ctx, "resourcely_blueprint", "Delete")
+	defer endResourceSpan(ctx, span, &req.State, &resp.Diagnostics)
+
 	// Retrieve from state
 	var state *BlueprintResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -349,10 +426,16 @@ func (r *BlueprintResource) Delete(
 
 	_, err := r.service.DeleteBlueprint(ctx, state.SeriesId.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
+		// Nothing left to delete if it was already deleted outside of Terraform
+		if client.IsNotFound(err) {
+			return
+		}
+
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Error deleting blueprint",
 			"Could not delete blueprint series id "+state.SeriesId.ValueString()+": "+err.Error(),
-		)
+			err,
+		))
 		return
 	}
 }
@@ -365,6 +448,31 @@ func (r *BlueprintResource) ImportState(
 	resource.ImportStatePassthroughID(ctx, path.Root("series_id"), req, resp)
 }
 
+func (r *BlueprintResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// Nothing to keep when creating or destroying the blueprint
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan BlueprintResourceModel
+	var state BlueprintResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Changing only is_published patches the current version. The API
+	// does not promise that a patch keeps the version, so the id and
+	// version are unknown for any change.
+	newVersion := len(changedAttributes(state, plan)) > 0
+	resp.Diagnostics.Append(planVersionAttributes(ctx, req.State, &resp.Plan, newVersion)...)
+}
+
 // Most blueprint fields are updated via an Update (Put) API call, but
 // a IsPublished is updated via a Patch API call. This function
 // examines the changed properties to determine which are needed.
@@ -380,41 +488,16 @@ func (r *BlueprintResource) computeUpdateActions(ctx context.Context, state Blue
 	}
 
 	// Determine if the Update fields have changed
-
-	// If patch is not needed, we know an update is needed. Terraform
-	// only calls us if there was some change. And it was't to a patch
-	// field...
-	if !needsPatch {
-		needsUpdate = true
-		return
-	}
-
-	// Otherwise, check all update fields
-	if !plan.Categories.Equal(state.Categories) {
-		needsUpdate = true
-	}
-
-	if !plan.Content.Equal(state.Content) {
-		needsUpdate = true
-	}
-
-	if !plan.Description.Equal(state.Description) {
-		needsUpdate = true
-	}
-
-	if !plan.ExcludedContextQuestionSeries.Equal(state.ExcludedContextQuestionSeries) {
-		needsUpdate = true
-	}
-
-	if !plan.Guidance.Equal(state.Guidance) {
-		needsUpdate = true
-	}
-
-	if !plan.Labels.Equal(state.Labels) {
-		needsUpdate = true
+	for _, name := range changedAttributes(state, plan) {
+		if name != "is_published" {
+			needsUpdate = true
+		}
 	}
 
-	if !plan.Name.Equal(state.Name) {
+	// Terraform only calls Update if there was some change, such as
+	// an unknown is_published that turned out to be unchanged. So if
+	// patch is not needed, we know an update is needed.
+	if !needsPatch {
 		needsUpdate = true
 	}
 
@@ -446,3 +529,14 @@ func (r *BlueprintResource) buildCommonFields(
 
 	return commonFields
 }
+
+// versionConflict describes an update that was rejected because the
+// blueprint changed in Resourcely after Terraform last read it.
+func (r *BlueprintResource) versionConflict(ctx context.Context, state BlueprintResourceModel, expected int64, err error) diag.Diagnostic {
+	seriesId := state.SeriesId.ValueString()
+	blueprint, _, getErr := r.service.GetBlueprintBySeriesId(ctx, seriesId)
+	if getErr != nil {
+		return versionConflictDiagnostic("blueprint", seriesId, expected, nil, nil, err)
+	}
+	return versionConflictDiagnostic("blueprint", seriesId, expected, &blueprint.Version, changedAttributes(state, FlattenBlueprint(blueprint)), err)
+}
@@ -1,13 +1,23 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"os"
 	"regexp"
 	"testing"
 
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/client"
+	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/fakeapi"
 )
 
 func TestAccBlueprintResource_basic(t *testing.T) {
@@ -72,6 +82,97 @@ func TestAccBlueprintResource_basic(t *testing.T) {
 	})
 }
 
+func TestAccBlueprintResource_publishOnlyPatches(t *testing.T) {
+	if os.Getenv(hostnameVar) != "" || os.Getenv(authTokenVar) != "" {
+		t.Skip("Telling a patch from an update needs the fake Resourcely API")
+	}
+	testAccUseFakeAPI(t)
+	name := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlueprintResourceConfig_publishable(name, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("resourcely_blueprint.publishable", "version", "1"),
+					resource.TestCheckResourceAttr("resourcely_blueprint.publishable", "is_published", "false"),
+				),
+			},
+			// Publishing only patches the current version. The fake API
+			// keeps its version, where an update would create version 2.
+			{
+				Config: testAccBlueprintResourceConfig_publishable(name, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("resourcely_blueprint.publishable", "version", "1"),
+					resource.TestCheckResourceAttr("resourcely_blueprint.publishable", "is_published", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestBlueprintResource_updateKeepsPutWhenPatchFails(t *testing.T) {
+	ctx := context.Background()
+	server := fakeapi.NewServer()
+	t.Cleanup(server.Close)
+	c, err := client.NewClient(nil, server.URL, server.Token)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	blueprint, _, err := c.Blueprints.CreateBlueprint(ctx, &client.NewBlueprint{
+		CommonBlueprintFields: client.CommonBlueprintFields{
+			Name:       "unpublished",
+			Content:    "content",
+			Categories: []string{"BLUEPRINT_BLOB_STORAGE"},
+		},
+		Provider: "PROVIDER_AMAZON",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := &BlueprintResource{service: c.Blueprints}
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	stateModel := FlattenBlueprint(blueprint)
+	if diags := state.Set(ctx, &stateModel); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	// Rename and publish the blueprint, which needs a put and a patch
+	planModel := stateModel
+	planModel.Id = types.StringUnknown()
+	planModel.Version = types.Int64Unknown()
+	planModel.Name = types.StringValue("renamed")
+	planModel.IsPublished = types.BoolValue(true)
+	plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw.Copy()}
+	if diags := plan.Set(ctx, &planModel); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	server.FailNextRequest(http.MethodPatch, http.StatusForbidden, "publishing is not allowed")
+	resp := fwresource.UpdateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: plan.Raw.Copy()}}
+	r.Update(ctx, fwresource.UpdateRequest{State: state, Plan: plan}, &resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected the failed patch to be reported")
+	}
+
+	var got BlueprintResourceModel
+	if diags := resp.State.Get(ctx, &got); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if got.Name.ValueString() != "renamed" || got.Version.ValueInt64() != 2 || got.IsPublished.ValueBool() {
+		t.Errorf("expected the unpublished version 2 from the put in state, got %s version %s published %s", got.Name, got.Version, got.IsPublished)
+	}
+}
+
 func importBlueprintBySeriesId(blueprintName string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		blueprint := s.RootModule().Resources[blueprintName]
@@ -131,3 +232,20 @@ resource "resourcely_blueprint" "basic" {
 }
 `, name)
 }
+
+func testAccBlueprintResourceConfig_publishable(name string, isPublished bool) string {
+	return fmt.Sprintf(`
+resource "resourcely_blueprint" "publishable" {
+  name = "%s"
+  cloud_provider = "PROVIDER_AMAZON"
+  categories = ["BLUEPRINT_BLOB_STORAGE"]
+  is_published = %t
+  content = <<-EOT
+              resource "aws_s3_bucket" "{{ resource_name }}" {
+                bucket = "{{ bucket }}"
+              }
+            EOT
+}
+
+`, name, isPublished)
+}
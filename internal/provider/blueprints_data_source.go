@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &BlueprintsDataSource{}
+
+func NewBlueprintsDataSource() datasource.DataSource {
+	return &BlueprintsDataSource{}
+}
+
+// BlueprintsDataSource defines the data source implementation.
+type BlueprintsDataSource struct {
+	service *client.BlueprintsService
+}
+
+// BlueprintsDataSourceModel describes the data source data model.
+type BlueprintsDataSourceModel struct {
+	Id types.String `tfsdk:"id"`
+
+	Name        types.String `tfsdk:"name"`
+	Provider    types.String `tfsdk:"cloud_provider"`
+	Categories  types.Set    `tfsdk:"categories"`
+	Labels      types.Set    `tfsdk:"labels"`
+	IsPublished types.Bool   `tfsdk:"is_published"`
+
+	Blueprints []BlueprintResourceModel `tfsdk:"blueprints"`
+}
+
+func (d *BlueprintsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blueprints"
+}
+
+func (d *BlueprintsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the [blueprints](https://docs.resourcely.io/build/setting-up-blueprints) in your Resourcely tenant that match all of the given filters. Omit every filter to list all blueprints.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "A placeholder identifier for this list.",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Only list the blueprints with exactly this name.",
+				Optional:            true,
+			},
+			"cloud_provider": schema.StringAttribute{
+				MarkdownDescription: "Only list the blueprints that target this cloud provider.",
+				Optional:            true,
+			},
+			"categories": schema.SetAttribute{
+				ElementType:         basetypes.StringType{},
+				MarkdownDescription: "Only list the blueprints in any of these categories.",
+				Optional:            true,
+			},
+			"labels": schema.SetAttribute{
+				ElementType:         basetypes.StringType{},
+				MarkdownDescription: "Only list the blueprints with any of these labels.",
+				Optional:            true,
+			},
+			"is_published": schema.BoolAttribute{
+				MarkdownDescription: "Only list the blueprints that are published (`true`) or unpublished (`false`).",
+				Optional:            true,
+			},
+			"blueprints": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching blueprints.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "UUID for the current version of the blueprint.",
+							Computed:            true,
+						},
+						"series_id": schema.StringAttribute{
+							MarkdownDescription: "UUID for the blueprint",
+							Computed:            true,
+						},
+						"version": schema.Int64Attribute{
+							MarkdownDescription: "Increment version number for the current version of the blueprint.",
+							Computed:            true,
+						},
+						"scope": schema.StringAttribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the blueprint.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "A description of the blueprints's purpose or functionality.",
+							Computed:            true,
+						},
+						"cloud_provider": schema.StringAttribute{
+							MarkdownDescription: "The cloud provider that this blueprint targets.",
+							Computed:            true,
+						},
+						"content": schema.StringAttribute{
+							MarkdownDescription: "The templated Terraform configuration specified using Resourcely's TFT format.",
+							Computed:            true,
+						},
+						"guidance": schema.StringAttribute{
+							MarkdownDescription: "Guidance to help your users know when and how to use this blueprint.",
+							Computed:            true,
+						},
+						"categories": schema.SetAttribute{
+							ElementType:         basetypes.StringType{},
+							Computed:            true,
+							MarkdownDescription: "The category to assign to this blueprint.",
+						},
+						"labels": schema.SetAttribute{
+							ElementType:         basetypes.StringType{},
+							Computed:            true,
+							MarkdownDescription: "Additional keywords to help your users discover this blueprint.",
+						},
+						"is_published": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "A published blueprint is available for use by developers to create resources through the Resourcely portal.",
+						},
+						"excluded_context_question_series": schema.SetAttribute{
+							ElementType:         basetypes.StringType{},
+							Computed:            true,
+							MarkdownDescription: "The series_ids for context questions that won't be used with this blueprint, even if this blueprint matches the context questions' blueprint_categories",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *BlueprintsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.service = client.Blueprints
+}
+
+func (d *BlueprintsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.service == nil {
+		resp.Diagnostics.Append(unknownConfigDiagnostic())
+		return
+	}
+
+	// Read the config
+	var config BlueprintsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := &client.BlueprintListOptions{
+		Name:        config.Name.ValueString(),
+		Provider:    config.Provider.ValueString(),
+		IsPublished: config.IsPublished.ValueBoolPointer(),
+	}
+	if !config.Categories.IsNull() {
+		resp.Diagnostics.Append(config.Categories.ElementsAs(ctx, &opts.Categories, false)...)
+	}
+	if !config.Labels.IsNull() {
+		resp.Diagnostics.Append(config.Labels.ElementsAs(ctx, &opts.Labels, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	blueprints, err := d.service.ListBlueprintsPaginator(opts).All(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Error listing blueprints",
+			"Could not list blueprints: "+err.Error(),
+			err,
+		))
+		return
+	}
+
+	// The API's name filter may not be exact
+	if opts.Name != "" {
+		blueprints = slices.DeleteFunc(blueprints, func(b client.Blueprint) bool { return b.Name != opts.Name })
+	}
+
+	config.Blueprints = make([]BlueprintResourceModel, len(blueprints))
+	for i := range blueprints {
+		config.Blueprints[i] = FlattenBlueprint(&blueprints[i])
+	}
+
+	config.Id = types.StringValue("blueprints")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
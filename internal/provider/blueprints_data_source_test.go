@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccBlueprintsDataSource_basic(t *testing.T) {
+	name := "basic_test_" + acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccBlueprintsDataSourceConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.resourcely_blueprints.by_name", "blueprints.#", "1"),
+					resource.TestCheckResourceAttrPair("data.resourcely_blueprints.by_name", "blueprints.0.series_id", "resourcely_blueprint.published", "series_id"),
+					resource.TestCheckResourceAttr("data.resourcely_blueprints.by_name", "blueprints.0.name", name),
+					resource.TestCheckResourceAttr("data.resourcely_blueprints.by_name", "blueprints.0.cloud_provider", "PROVIDER_AMAZON"),
+					resource.TestCheckResourceAttr("data.resourcely_blueprints.by_name", "blueprints.0.categories.0", "BLUEPRINT_BLOB_STORAGE"),
+					resource.TestCheckResourceAttr("data.resourcely_blueprints.by_name", "blueprints.0.labels.0", "marketing"),
+					resource.TestCheckResourceAttr("data.resourcely_blueprints.by_name", "blueprints.0.is_published", "true"),
+					resource.TestCheckResourceAttr("data.resourcely_blueprints.unpublished", "blueprints.#", "1"),
+					resource.TestCheckResourceAttrPair("data.resourcely_blueprints.unpublished", "blueprints.0.series_id", "resourcely_blueprint.unpublished", "series_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBlueprintsDataSourceConfig_basic(name string) string {
+	return fmt.Sprintf(`
+resource "resourcely_blueprint" "published" {
+  name = "%[1]s"
+  cloud_provider = "PROVIDER_AMAZON"
+  categories = ["BLUEPRINT_BLOB_STORAGE"]
+  labels = ["marketing"]
+  content = <<-EOT
+              resource "aws_s3_bucket" "{{ resource_name }}" {
+                bucket = "{{ bucket }}"
+              }
+            EOT
+
+  is_published = true
+}
+
+resource "resourcely_blueprint" "unpublished" {
+  name = "%[1]s"
+  cloud_provider = "PROVIDER_AMAZON"
+  categories = ["BLUEPRINT_BLOB_STORAGE"]
+  content = <<-EOT
+              resource "aws_s3_bucket" "{{ resource_name }}" {
+                bucket = "{{ bucket }}"
+              }
+            EOT
+
+  is_published = false
+}
+
+data "resourcely_blueprints" "by_name" {
+  name = "%[1]s"
+  cloud_provider = "PROVIDER_AMAZON"
+  labels = ["marketing"]
+  is_published = true
+
+  depends_on = [resourcely_blueprint.published, resourcely_blueprint.unpublished]
+}
+
+data "resourcely_blueprints" "unpublished" {
+  name = "%[1]s"
+  categories = ["BLUEPRINT_BLOB_STORAGE"]
+  is_published = false
+
+  depends_on = [resourcely_blueprint.published, resourcely_blueprint.unpublished]
+}
+`, name)
+}
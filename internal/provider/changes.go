@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Attributes that change with every new version, so listing them would
+// not say anything about what changed.
+var versionAttributes = map[string]bool{
+	"id":      true,
+	"version": true,
+}
+
+// changedAttributes returns the names of the attributes whose values
+// differ between two models of the same type, in the order the model
+// declares them. Attributes of embedded structs are compared too.
+func changedAttributes(before any, after any) []string {
+	return changedFields(reflect.ValueOf(before), reflect.ValueOf(after))
+}
+
+func changedFields(before reflect.Value, after reflect.Value) []string {
+	var changed []string
+	for i := 0; i < before.NumField(); i++ {
+		field := before.Type().Field(i)
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			changed = append(changed, changedFields(before.Field(i), after.Field(i))...)
+			continue
+		}
+
+		name := field.Tag.Get("tfsdk")
+		if name == "" || name == "-" || versionAttributes[name] {
+			continue
+		}
+		beforeValue, ok := before.Field(i).Interface().(attr.Value)
+		if !ok {
+			continue
+		}
+		afterValue, _ := after.Field(i).Interface().(attr.Value)
+		if !beforeValue.Equal(afterValue) {
+			changed = append(changed, name)
+		}
+	}
+	return changed
+}
+
+// versionConflictDiagnostic explains an update that the Resourcely API
+// rejected because the entity changed since Terraform last read it. It
+// names the current version and the attributes changed since the
+// version Terraform expected. A nil current means the current version
+// could not be read.
+func versionConflictDiagnostic(kind string, seriesId string, expected int64, current *int64, changed []string, err error) diag.Diagnostic {
+	detail := fmt.Sprintf("The %s series id %s was changed outside of Terraform after it was last read. ", kind, seriesId)
+	if current != nil {
+		detail += fmt.Sprintf("Terraform expected version %d, but Resourcely is at version %d.", expected, *current)
+	} else {
+		detail += fmt.Sprintf("Terraform expected version %d: %s", expected, err.Error())
+	}
+	if len(changed) > 0 {
+		detail += "\n\nChanged attributes: " + strings.Join(changed, ", ") + "."
+	}
+	detail += "\n\nThe update was not applied, so that it does not overwrite those changes. " +
+		"Run terraform plan to review the differences against the current version, then apply again."
+
+	return diag.NewErrorDiagnostic("Error updating "+kind, detail)
+}
+
+// driftDiagnostic warns that an entity was changed outside of
+// Terraform since it was last read, naming the versions, the user who
+// made the change if the API reports it, and the changed attributes.
+func driftDiagnostic(kind string, seriesId string, before int64, after int64, updatedBy string, changed []string) diag.Diagnostic {
+	detail := fmt.Sprintf("The %s series id %s was changed outside of Terraform, from version %d to version %d", kind, seriesId, before, after)
+	if updatedBy != "" {
+		detail += " by " + updatedBy
+	}
+	detail += "."
+	if len(changed) > 0 {
+		detail += "\n\nChanged attributes: " + strings.Join(changed, ", ") + "."
+	}
+	detail += "\n\nTerraform will plan to revert any changed attributes that differ from the configuration."
+
+	return diag.NewWarningDiagnostic("Resourcely "+kind+" changed outside of Terraform", detail)
+}
+
+// versionChanged reports whether the version in state is known and
+// differs from the current version, so that the entity has changed
+// since Terraform last read it. Imported entities have no version in
+// state yet.
+func versionChanged(state types.Int64, current int64) bool {
+	return !state.IsNull() && !state.IsUnknown() && state.ValueInt64() != current
+}
+
+// checkVersion reports a conflict if the entity changed in Resourcely
+// after Terraform last read it at version want. Updates call it before
+// they write anything: they also send want in an If-Match header, but
+// that only protects the update if the API honors it.
+//
+// get reads the current version of the entity, version returns its
+// version number, and changed lists the attributes that differ from
+// state.
+func checkVersion[T any](
+	ctx context.Context,
+	kind string,
+	seriesId string,
+	want types.Int64,
+	get func(context.Context) (*T, error),
+	version func(*T) int64,
+	changed func(*T) []string,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	current, err := get(ctx)
+	if err != nil {
+		diags.Append(apiErrorDiagnostic(
+			"Error updating "+kind,
+			"Could not read "+kind+" series id "+seriesId+": "+err.Error(),
+			err,
+		))
+		return diags
+	}
+	currentVersion := version(current)
+	if !versionChanged(want, currentVersion) {
+		return diags
+	}
+
+	diags.Append(versionConflictDiagnostic(kind, seriesId, want.ValueInt64(), &currentVersion, changed(current), nil))
+	return diags
+}
+
+// planVersionAttributes plans the id and version attributes of an
+// update. They are unknown if the update will create a new version, and
+// otherwise keep their values from state, so that a plan without any
+// change does not ripple into the resources that refer to them.
+func planVersionAttributes(ctx context.Context, state tfsdk.State, plan *tfsdk.Plan, newVersion bool) diag.Diagnostics {
+	if newVersion {
+		var diags diag.Diagnostics
+		diags.Append(plan.SetAttribute(ctx, path.Root("id"), types.StringUnknown())...)
+		diags.Append(plan.SetAttribute(ctx, path.Root("version"), types.Int64Unknown())...)
+		return diags
+	}
+
+	var id types.String
+	var version types.Int64
+	diags := state.GetAttribute(ctx, path.Root("id"), &id)
+	diags.Append(state.GetAttribute(ctx, path.Root("version"), &version)...)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(plan.SetAttribute(ctx, path.Root("id"), id)...)
+	diags.Append(plan.SetAttribute(ctx, path.Root("version"), version)...)
+	return diags
+}
@@ -0,0 +1,262 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/client"
+	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/fakeapi"
+)
+
+func TestChangedAttributes(t *testing.T) {
+	blueprint := &client.Blueprint{
+		Id:      "1",
+		Version: 1,
+		CommonBlueprintFields: client.CommonBlueprintFields{
+			Name:       "name",
+			Content:    "content",
+			Categories: []string{"BLUEPRINT_BLOB_STORAGE"},
+		},
+	}
+	before := FlattenBlueprint(blueprint)
+
+	blueprint.Id = "2"
+	blueprint.Version = 2
+	blueprint.Content = "edited content"
+	blueprint.Guidance = "new guidance"
+	after := FlattenBlueprint(blueprint)
+
+	want := []string{"content", "guidance"}
+	if got := changedAttributes(before, after); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestGuardrailResource_readWarnsAboutDrift(t *testing.T) {
+	ctx := context.Background()
+	server := fakeapi.NewServer()
+	t.Cleanup(server.Close)
+	c, err := client.NewClient(nil, server.URL, server.Token)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fields := client.CommonGuardrailFields{
+		Name:     "drifted",
+		Provider: "PROVIDER_AMAZON",
+		Category: "GUARDRAIL_BEST_PRACTICES",
+		State:    "GUARDRAIL_STATE_ACTIVE",
+		Content:  "content",
+	}
+	guardrail, _, err := c.Guardrails.CreateGuardrail(ctx, &client.NewGuardrail{CommonGuardrailFields: fields})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := &GuardrailResource{service: c.Guardrails}
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	var model GuardrailResourceModel
+	if diags := FlattenGuardrail(guardrail, &model); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if diags := state.Set(ctx, &model); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	read := func() resource.ReadResponse {
+		resp := resource.ReadResponse{State: state}
+		r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatal(resp.Diagnostics)
+		}
+		return resp
+	}
+
+	if resp := read(); resp.Diagnostics.WarningsCount() != 0 {
+		t.Errorf("expected no warning without a change, got %v", resp.Diagnostics)
+	}
+
+	// Edit the guardrail as a colleague might in the portal
+	fields.Content = "edited content"
+	fields.State = "GUARDRAIL_STATE_INACTIVE"
+	if _, _, err := c.Guardrails.UpdateGuardrail(ctx, &client.UpdatedGuardrail{SeriesId: guardrail.SeriesId, CommonGuardrailFields: fields}); err != nil {
+		t.Fatal(err)
+	}
+
+	resp := read()
+	warnings := resp.Diagnostics.Warnings()
+	if len(warnings) != 1 {
+		t.Fatalf("expected a drift warning, got %v", resp.Diagnostics)
+	}
+	for _, want := range []string{"from version 1 to version 2 by " + fakeapi.DefaultSubject, "Changed attributes: state, content."} {
+		if !strings.Contains(warnings[0].Detail(), want) {
+			t.Errorf("expected %q in the warning, got %q", want, warnings[0].Detail())
+		}
+	}
+}
+
+func TestBlueprintResource_modifyPlan(t *testing.T) {
+	ctx := context.Background()
+	// ModifyPlan must not need the client, which is missing while the
+	// provider configuration is unknown.
+	r := &BlueprintResource{}
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	blueprint := &client.Blueprint{
+		Id:       "1",
+		SeriesId: "series",
+		Version:  1,
+		CommonBlueprintFields: client.CommonBlueprintFields{
+			Name:       "name",
+			Content:    "content",
+			Categories: []string{"BLUEPRINT_BLOB_STORAGE"},
+		},
+	}
+	stateModel := FlattenBlueprint(blueprint)
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	if diags := state.Set(ctx, &stateModel); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	tests := map[string]struct {
+		change      func(*BlueprintResourceModel)
+		wantVersion bool
+	}{
+		"no change": {
+			change:      func(*BlueprintResourceModel) {},
+			wantVersion: true,
+		},
+		"publish": {
+			change:      func(m *BlueprintResourceModel) { m.IsPublished = types.BoolValue(true) },
+			wantVersion: false,
+		},
+		"unknown is_published": {
+			change:      func(m *BlueprintResourceModel) { m.IsPublished = types.BoolUnknown() },
+			wantVersion: false,
+		},
+		"content": {
+			change:      func(m *BlueprintResourceModel) { m.Content = types.StringValue("edited content") },
+			wantVersion: false,
+		},
+		"content and publish": {
+			change: func(m *BlueprintResourceModel) {
+				m.Content = types.StringValue("edited content")
+				m.IsPublished = types.BoolValue(true)
+			},
+			wantVersion: false,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			planModel := stateModel
+			planModel.Id = types.StringUnknown()
+			planModel.Version = types.Int64Unknown()
+			test.change(&planModel)
+			plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw.Copy()}
+			if diags := plan.Set(ctx, &planModel); diags.HasError() {
+				t.Fatal(diags)
+			}
+
+			resp := resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{State: state, Plan: plan}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatal(resp.Diagnostics)
+			}
+
+			var got BlueprintResourceModel
+			if diags := resp.Plan.Get(ctx, &got); diags.HasError() {
+				t.Fatal(diags)
+			}
+			if test.wantVersion {
+				if !got.Id.Equal(stateModel.Id) || !got.Version.Equal(stateModel.Version) {
+					t.Errorf("expected id and version from state, got %s and %s", got.Id, got.Version)
+				}
+			} else if !got.Id.IsUnknown() || !got.Version.IsUnknown() {
+				t.Errorf("expected unknown id and version, got %s and %s", got.Id, got.Version)
+			}
+		})
+	}
+}
+
+func TestGuardrailResource_updateChecksVersion(t *testing.T) {
+	ctx := context.Background()
+	server := fakeapi.NewServer()
+	// Like an API that does not support If-Match
+	server.IgnoreIfMatch = true
+	t.Cleanup(server.Close)
+	c, err := client.NewClient(nil, server.URL, server.Token)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fields := client.CommonGuardrailFields{
+		Name:     "checked",
+		Provider: "PROVIDER_AMAZON",
+		Category: "GUARDRAIL_BEST_PRACTICES",
+		State:    "GUARDRAIL_STATE_ACTIVE",
+		Content:  "content",
+	}
+	guardrail, _, err := c.Guardrails.CreateGuardrail(ctx, &client.NewGuardrail{CommonGuardrailFields: fields})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := &GuardrailResource{service: c.Guardrails}
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	var model GuardrailResourceModel
+	if diags := FlattenGuardrail(guardrail, &model); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if diags := state.Set(ctx, &model); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	// Edit the guardrail after Terraform read it
+	edited := fields
+	edited.Content = "edited content"
+	if _, _, err := c.Guardrails.UpdateGuardrail(ctx, &client.UpdatedGuardrail{SeriesId: guardrail.SeriesId, CommonGuardrailFields: edited}); err != nil {
+		t.Fatal(err)
+	}
+
+	planModel := model
+	planModel.Name = types.StringValue("renamed")
+	plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw.Copy()}
+	if diags := plan.Set(ctx, &planModel); diags.HasError() {
+		t.Fatal(diags)
+	}
+	resp := resource.UpdateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: plan.Raw.Copy()}}
+	r.Update(ctx, resource.UpdateRequest{State: state, Plan: plan}, &resp)
+
+	errs := resp.Diagnostics.Errors()
+	if len(errs) != 1 || !strings.Contains(errs[0].Detail(), "Terraform expected version 1, but Resourcely is at version 2.") {
+		t.Fatalf("expected a version conflict, got %v", resp.Diagnostics)
+	}
+	current, _, err := c.Guardrails.GetGuardrailBySeriesId(ctx, guardrail.SeriesId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if current.Version != 2 || current.Content != "edited content" {
+		t.Errorf("expected the edit to be kept, got version %d with content %q", current.Version, current.Content)
+	}
+}
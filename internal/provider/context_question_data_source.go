@@ -8,12 +8,17 @@ import (
 
 	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/client"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
-var _ datasource.DataSource = &ContextQuestionDataSource{}
+var (
+	_ datasource.DataSource                     = &ContextQuestionDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &ContextQuestionDataSource{}
+)
 
 func NewContextQuestionDataSource() datasource.DataSource {
 	return &ContextQuestionDataSource{}
@@ -37,8 +42,9 @@ func (d *ContextQuestionDataSource) Schema(_ context.Context, _ datasource.Schem
 				Computed:            true,
 			},
 			"series_id": schema.StringAttribute{
-				MarkdownDescription: "UUID for the context question.",
-				Required:            true,
+				MarkdownDescription: "UUID for the context question. Exactly one of `series_id` or `label` must be set.",
+				Optional:            true,
+				Computed:            true,
 			},
 			"version": schema.Int64Attribute{
 				MarkdownDescription: "Incrementing version number of the context question.",
@@ -49,7 +55,8 @@ func (d *ContextQuestionDataSource) Schema(_ context.Context, _ datasource.Schem
 				Computed:            true,
 			},
 			"label": schema.StringAttribute{
-				MarkdownDescription: "A key used to reference the context question in blueprints and guardrails. Is unique within your Resourcley tenant.",
+				MarkdownDescription: "A key used to reference the context question in blueprints and guardrails. Is unique within your Resourcley tenant. Exactly one of `series_id` or `label` must be set.",
+				Optional:            true,
 				Computed:            true,
 			},
 			"prompt": schema.StringAttribute{
@@ -114,7 +121,21 @@ func (d *ContextQuestionDataSource) Configure(_ context.Context, req datasource.
 	d.service = client.ContextQuestions
 }
 
+func (d *ContextQuestionDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("series_id"),
+			path.MatchRoot("label"),
+		),
+	}
+}
+
 func (d *ContextQuestionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.service == nil {
+		resp.Diagnostics.Append(unknownConfigDiagnostic())
+		return
+	}
+
 	// Read the config
 	var config ContextQuestionResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
@@ -122,15 +143,39 @@ func (d *ContextQuestionDataSource) Read(ctx context.Context, req datasource.Rea
 		return
 	}
 
-	contextQuestionSeriesId := config.SeriesId.ValueString()
-
-	contextQuestionRead, _, err := d.service.GetContextQuestionBySeriesId(ctx, contextQuestionSeriesId)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error reading ContextQuestion",
-			"Could not read ContextQuestion series id "+contextQuestionSeriesId+": "+err.Error(),
-		)
-		return
+	var contextQuestionRead *client.ContextQuestion
+	var err error
+	if !config.SeriesId.IsNull() {
+		contextQuestionSeriesId := config.SeriesId.ValueString()
+
+		contextQuestionRead, _, err = d.service.GetContextQuestionBySeriesId(ctx, contextQuestionSeriesId)
+		if err != nil {
+			resp.Diagnostics.Append(apiErrorDiagnostic(
+				"Error reading ContextQuestion",
+				"Could not read ContextQuestion series id "+contextQuestionSeriesId+": "+err.Error(),
+				err,
+			))
+			return
+		}
+	} else {
+		contextQuestionLabel := config.Label.ValueString()
+
+		contextQuestionRead, _, err = d.service.GetContextQuestionByLabel(ctx, contextQuestionLabel)
+		if err != nil {
+			resp.Diagnostics.Append(apiErrorDiagnostic(
+				"Error reading ContextQuestion",
+				"Could not read ContextQuestion label "+contextQuestionLabel+": "+err.Error(),
+				err,
+			))
+			return
+		}
+		if contextQuestionRead == nil {
+			resp.Diagnostics.AddError(
+				"ContextQuestion not found",
+				"No ContextQuestion has the label "+contextQuestionLabel+".",
+			)
+			return
+		}
 	}
 
 	// Overwrite state with refreshed value
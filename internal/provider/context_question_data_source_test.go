@@ -53,3 +53,39 @@ data "resourcely_context_question" "by_series_id" {
 }
 `, label)
 }
+
+func TestAccContextQuestionDataSource_byLabel(t *testing.T) {
+	rLabel := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccContextQuestionDataSourceConfig_byLabel(rLabel),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.resourcely_context_question.by_label", "series_id", "resourcely_context_question.basic", "series_id"),
+					resource.TestCheckResourceAttr("data.resourcely_context_question.by_label", "label", rLabel),
+					resource.TestCheckResourceAttr("data.resourcely_context_question.by_label", "prompt", "what is your prompt?"),
+				),
+			},
+		},
+	})
+}
+
+func testAccContextQuestionDataSourceConfig_byLabel(label string) string {
+	return fmt.Sprintf(`
+resource "resourcely_context_question" "basic" {
+	prompt = "what is your prompt?"
+	qtype = "QTYPE_TEXT"
+	scope = "SCOPE_TENANT"
+	blueprint_categories = ["BLUEPRINT_BLOB_STORAGE"]
+	label = "%s"
+}
+
+data "resourcely_context_question" "by_label" {
+  label = resourcely_context_question.basic.label
+}
+`, label)
+}
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
-	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -15,6 +14,7 @@ import (
 
 	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/client"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -29,6 +29,7 @@ import (
 var (
 	_ resource.Resource                = &ContextQuestionResource{}
 	_ resource.ResourceWithImportState = &ContextQuestionResource{}
+	_ resource.ResourceWithModifyPlan  = &ContextQuestionResource{}
 )
 
 func NewContextQuestionResource() resource.Resource {
@@ -180,6 +181,9 @@ func (r *ContextQuestionResource) Configure(_ context.Context, req resource.Conf
 }
 
 func (r *ContextQuestionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := startResourceSpan(ctx, "resourcely_context_question", "Create")
+	defer endResourceSpan(ctx, span, &resp.State, &resp.Diagnostics)
+
 	// Get the plan
 	var plan ContextQuestionResourceModel
 
@@ -196,10 +200,28 @@ func (r *ContextQuestionResource) Create(ctx context.Context, req resource.Creat
 
 	ContextQuestion, _, err := r.service.CreateContextQuestion(ctx, newContextQuestion)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		ContextQuestion = adoptCreated(ctx, err,
+			func(ctx context.Context) (*client.ContextQuestion, error) {
+				contextQuestion, _, err := r.service.GetContextQuestionByLabel(ctx, newContextQuestion.Label)
+				return contextQuestion, err
+			},
+			func(contextQuestion *client.ContextQuestion) int64 { return contextQuestion.Version },
+			func(contextQuestion *client.ContextQuestion) bool {
+				return contextQuestion.Prompt == newContextQuestion.Prompt && contextQuestion.Qtype == newContextQuestion.Qtype
+			},
+		)
+		if ContextQuestion != nil {
+			resp.Diagnostics.Append(adoptedWarning("Global Context", ContextQuestion.SeriesId, err))
+			err = nil
+		}
+	}
+	if err != nil {
+		resp.Diagnostics.Append(apiPlanErrorDiagnostics(
+			ctx, req.Plan, nil,
 			"Error creating Global Context",
 			"Could not create Global Context: "+err.Error(),
-		)
+			err,
+		)...)
 		return
 	}
 
@@ -209,6 +231,13 @@ func (r *ContextQuestionResource) Create(ctx context.Context, req resource.Creat
 }
 
 func (r *ContextQuestionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := startResourceSpan(ctx, "resourcely_context_question", "Read")
+	defer endResourceSpan(ctx, span, &resp.State, &resp.Diagnostics)
+
+	if awaitingConfig(r.service) {
+		return
+	}
+
 	// Get the current state
 	var state ContextQuestionResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -217,9 +246,9 @@ func (r *ContextQuestionResource) Read(ctx context.Context, req resource.ReadReq
 	}
 
 	// Refresh value from the remote API
-	contextQuestionResponse, httpResp, err := r.service.GetContextQuestionBySeriesId(ctx, state.SeriesId.ValueString())
+	contextQuestionResponse, _, err := r.service.GetContextQuestionBySeriesId(ctx, state.SeriesId.ValueString())
 	if err != nil {
-		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+		if client.IsNotFound(err) {
 			resp.Diagnostics.AddWarning(
 				"Global Context "+state.SeriesId.ValueString()+" was not found in Resourcely",
 				"The Global Context may have been deleted outside of Terraform",
@@ -227,20 +256,28 @@ func (r *ContextQuestionResource) Read(ctx context.Context, req resource.ReadReq
 			resp.State.RemoveResource(ctx)
 			return
 		} else {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(apiErrorDiagnostic(
 				"Error reading Global Context",
 				"Could not read Global Context series id "+state.SeriesId.ValueString()+": "+err.Error(),
-			)
+				err,
+			))
 			return
 		}
 	}
 
 	// Overwrite state with refreshed value
-	state = FlattenContextQuestion(contextQuestionResponse)
+	current := FlattenContextQuestion(contextQuestionResponse)
+	if versionChanged(state.Version, contextQuestionResponse.Version) {
+		resp.Diagnostics.Append(driftDiagnostic("Global Context", contextQuestionResponse.SeriesId, state.Version.ValueInt64(), contextQuestionResponse.Version, contextQuestionResponse.UpdatedBy, changedAttributes(state, current)))
+	}
+	state = current
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *ContextQuestionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := startResourceSpan(ctx, "resourcely_context_question", "Update")
+	defer endResourceSpan(ctx, span, &resp.State, &resp.Diagnostics)
+
 	// Retrieve the plan and state
 	var plan ContextQuestionResourceModel
 	var state ContextQuestionResourceModel
@@ -250,18 +287,39 @@ func (r *ContextQuestionResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	resp.Diagnostics.Append(checkVersion(ctx, "Global Context", state.SeriesId.ValueString(), state.Version,
+		func(ctx context.Context) (*client.ContextQuestion, error) {
+			contextQuestion, _, err := r.service.GetContextQuestionBySeriesId(ctx, state.SeriesId.ValueString())
+			return contextQuestion, err
+		},
+		func(contextQuestion *client.ContextQuestion) int64 { return contextQuestion.Version },
+		func(contextQuestion *client.ContextQuestion) []string {
+			return changedAttributes(state, FlattenContextQuestion(contextQuestion))
+		},
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update the resource
 	updatedContextQuestion := &client.UpdatedContextQuestion{
 		SeriesId:                    state.SeriesId.ValueString(),
+		Version:                     state.Version.ValueInt64(),
 		CommonContextQuestionFields: r.buildCommonFields(ctx, plan),
 	}
 
 	contextQuestion, _, err := r.service.UpdateContextQuestion(ctx, updatedContextQuestion)
+	if client.IsVersionMismatch(err) {
+		resp.Diagnostics.Append(r.versionConflict(ctx, state, err))
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apiPlanErrorDiagnostics(
+			ctx, req.Plan, nil,
 			"Error updating Global Context",
 			"Could not update Global Context series id "+state.SeriesId.ValueString()+": "+err.Error(),
-		)
+			err,
+		)...)
 		return
 	}
 
@@ -271,6 +329,9 @@ func (r *ContextQuestionResource) Update(ctx context.Context, req resource.Updat
 }
 
 func (r *ContextQuestionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := startResourceSpan(ctx, "resourcely_context_question", "Delete")
+	defer endResourceSpan(ctx, span, &req.State, &resp.Diagnostics)
+
 	// Retrieve from state
 	var state *ContextQuestionResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -280,10 +341,16 @@ func (r *ContextQuestionResource) Delete(ctx context.Context, req resource.Delet
 
 	_, err := r.service.DeleteContextQuestion(ctx, state.SeriesId.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
+		// Nothing left to delete if it was already deleted outside of Terraform
+		if client.IsNotFound(err) {
+			return
+		}
+
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Error deleting context question",
 			"Could not delete context question series id "+state.SeriesId.ValueString()+": "+err.Error(),
-		)
+			err,
+		))
 		return
 	}
 }
@@ -292,6 +359,24 @@ func (r *ContextQuestionResource) ImportState(ctx context.Context, req resource.
 	resource.ImportStatePassthroughID(ctx, path.Root("series_id"), req, resp)
 }
 
+func (r *ContextQuestionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to keep when creating or destroying the context question
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ContextQuestionResourceModel
+	var state ContextQuestionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newVersion := len(changedAttributes(state, plan)) > 0
+	resp.Diagnostics.Append(planVersionAttributes(ctx, req.State, &resp.Plan, newVersion)...)
+}
+
 func (r *ContextQuestionResource) buildCommonFields(ctx context.Context, plan ContextQuestionResourceModel) client.CommonContextQuestionFields {
 	commonFields := client.CommonContextQuestionFields{
 		Label:               plan.Label.ValueString(),
@@ -319,3 +404,15 @@ func (r *ContextQuestionResource) buildCommonFields(ctx context.Context, plan Co
 
 	return commonFields
 }
+
+// versionConflict describes an update that was rejected because the
+// context question changed in Resourcely after Terraform last read it.
+func (r *ContextQuestionResource) versionConflict(ctx context.Context, state ContextQuestionResourceModel, err error) diag.Diagnostic {
+	seriesId := state.SeriesId.ValueString()
+	expected := state.Version.ValueInt64()
+	contextQuestion, _, getErr := r.service.GetContextQuestionBySeriesId(ctx, seriesId)
+	if getErr != nil {
+		return versionConflictDiagnostic("Global Context", seriesId, expected, nil, nil, err)
+	}
+	return versionConflictDiagnostic("Global Context", seriesId, expected, &contextQuestion.Version, changedAttributes(state, FlattenContextQuestion(contextQuestion)), err)
+}
@@ -116,3 +116,66 @@ resource "resourcely_context_question" "usedefaults" {
 }
 `, prompt, label)
 }
+
+func TestAccContextQuestionResource_errorsDuplicateLabel(t *testing.T) {
+	label := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               ErrorCheckExpectedErrorMessagesContaining(t, "conflicts with"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContextQuestionResourceConfig_duplicateLabel(label),
+			},
+		},
+	})
+}
+
+func testAccContextQuestionResourceConfig_duplicateLabel(label string) string {
+	return fmt.Sprintf(`
+resource "resourcely_context_question" "first" {
+	prompt = "first"
+	qtype = "QTYPE_TEXT"
+	scope = "SCOPE_TENANT"
+	label = "%[1]s"
+}
+
+resource "resourcely_context_question" "second" {
+	prompt = "second"
+	qtype = "QTYPE_TEXT"
+	scope = "SCOPE_TENANT"
+	label = "%[1]s"
+
+	depends_on = [resourcely_context_question.first]
+}
+`, label)
+}
+
+func TestAccContextQuestionResource_errorsInvalidRegex(t *testing.T) {
+	label := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               ErrorCheckExpectedErrorMessagesContaining(t, "rejected this value"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContextQuestionResourceConfig_invalidRegex(label),
+			},
+		},
+	})
+}
+
+func testAccContextQuestionResourceConfig_invalidRegex(label string) string {
+	return fmt.Sprintf(`
+resource "resourcely_context_question" "invalid_regex" {
+	prompt = "what is your prompt?"
+	qtype = "QTYPE_TEXT"
+	answer_format = "ANSWER_REGEX"
+	scope = "SCOPE_TENANT"
+	label = "%s"
+	regex_pattern = "(unclosed"
+}
+`, label)
+}
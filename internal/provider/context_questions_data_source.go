@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &ContextQuestionsDataSource{}
+
+func NewContextQuestionsDataSource() datasource.DataSource {
+	return &ContextQuestionsDataSource{}
+}
+
+// ContextQuestionsDataSource defines the data source implementation.
+type ContextQuestionsDataSource struct {
+	service *client.ContextQuestionsService
+}
+
+// ContextQuestionsDataSourceModel describes the data source data model.
+type ContextQuestionsDataSourceModel struct {
+	Id types.String `tfsdk:"id"`
+
+	Qtype               types.String `tfsdk:"qtype"`
+	Scope               types.String `tfsdk:"scope"`
+	BlueprintCategories types.Set    `tfsdk:"blueprint_categories"`
+
+	ContextQuestions []ContextQuestionResourceModel `tfsdk:"context_questions"`
+}
+
+func (d *ContextQuestionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_context_questions"
+}
+
+func (d *ContextQuestionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the [context questions](https://docs.resourcely.io/concepts/other-features-and-settings/global-context-and-values) in your Resourcely tenant that match all of the given filters. Omit every filter to list all context questions.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "A placeholder identifier for this list.",
+				Computed:            true,
+			},
+			"qtype": schema.StringAttribute{
+				MarkdownDescription: "Only list the context questions of this type. One of `QTYPE_TEXT`, `QTYPE_SINGLE_SELECT`, or `QTYPE_MULTI_SELECT`.",
+				Optional:            true,
+			},
+			"scope": schema.StringAttribute{
+				MarkdownDescription: "Only list the context questions with this scope.",
+				Optional:            true,
+			},
+			"blueprint_categories": schema.SetAttribute{
+				MarkdownDescription: "Only list the context questions that apply to any of these blueprint categories.",
+				ElementType:         basetypes.StringType{},
+				Optional:            true,
+			},
+			"context_questions": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching context questions.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "UUID for the current version of this context question.",
+							Computed:            true,
+						},
+						"series_id": schema.StringAttribute{
+							MarkdownDescription: "UUID for the context question.",
+							Computed:            true,
+						},
+						"version": schema.Int64Attribute{
+							MarkdownDescription: "Incrementing version number of the context question.",
+							Computed:            true,
+						},
+						"scope": schema.StringAttribute{
+							MarkdownDescription: "",
+							Computed:            true,
+						},
+						"label": schema.StringAttribute{
+							MarkdownDescription: "A key used to reference the context question in blueprints and guardrails. Is unique within your Resourcley tenant.",
+							Computed:            true,
+						},
+						"prompt": schema.StringAttribute{
+							MarkdownDescription: "The question that Resourcely will ask your developers.",
+							Computed:            true,
+						},
+						"qtype": schema.StringAttribute{
+							MarkdownDescription: "The type of the question. Will be one of `QYTPE_TEXT`, `QYTPE_SINGLE_SELECT`, or `QTYPE_MULTI_SELECT`.",
+							Computed:            true,
+						},
+						"answer_format": schema.StringAttribute{
+							MarkdownDescription: "A format validation for acceptable answers to the context question. Applicable only when `qtype` is `QTYPE_TEXT` . Will be one of `ANSWER_TEXT`, `ANSWER_NUMBER`, `ANSWER_EMAIL`, or `ANSWER_REGEX`. If `ANSWER_REGEX`, the `regex_pattern` property will also be set.",
+							Computed:            true,
+						},
+						"answer_choices": schema.SetNestedAttribute{
+							MarkdownDescription: "The answer choices from which the developer can select. Applicable only when `qtype` is `QTYPE_SINGLE_SELECT` or `QTYPE_MULTI_SELECT`.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"label": schema.StringAttribute{
+										MarkdownDescription: "The value for the answer choice.",
+										Computed:            true,
+									},
+								},
+							},
+						},
+						"blueprint_categories": schema.SetAttribute{
+							MarkdownDescription: "The blueprint categories to which this context question applies. This question will be asked whenever a developer uses a blueprint in these categories.",
+							ElementType:         basetypes.StringType{},
+							Computed:            true,
+						},
+						"regex_pattern": schema.StringAttribute{
+							MarkdownDescription: "A regex validation for the acceptable answers to the context question. Applicable only when both `qtype` is `QTYPE_TEXT` and `answer_format` is `ANSWER_REGEX`.",
+							Computed:            true,
+						},
+						"priority": schema.Int64Attribute{
+							MarkdownDescription: "The priority of this question, relative to others. 0=high, 1=medium, 2=low",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ContextQuestionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.service = client.ContextQuestions
+}
+
+func (d *ContextQuestionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.service == nil {
+		resp.Diagnostics.Append(unknownConfigDiagnostic())
+		return
+	}
+
+	// Read the config
+	var config ContextQuestionsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := &client.ContextQuestionListOptions{
+		Qtype: config.Qtype.ValueString(),
+		Scope: config.Scope.ValueString(),
+	}
+	if !config.BlueprintCategories.IsNull() {
+		resp.Diagnostics.Append(config.BlueprintCategories.ElementsAs(ctx, &opts.BlueprintCategories, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	contextQuestions, err := d.service.ListContextQuestionsPaginator(opts).All(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Error listing context questions",
+			"Could not list context questions: "+err.Error(),
+			err,
+		))
+		return
+	}
+
+	config.ContextQuestions = make([]ContextQuestionResourceModel, len(contextQuestions))
+	for i := range contextQuestions {
+		config.ContextQuestions[i] = FlattenContextQuestion(&contextQuestions[i])
+	}
+
+	config.Id = types.StringValue("context_questions")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccContextQuestionsDataSource_basic(t *testing.T) {
+	label := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccContextQuestionsDataSourceConfig_basic(label),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.resourcely_context_questions.by_category", "context_questions.*", map[string]string{
+						"label":                  label,
+						"qtype":                  "QTYPE_SINGLE_SELECT",
+						"answer_choices.#":       "1",
+						"blueprint_categories.0": "BLUEPRINT_BLOB_STORAGE",
+						"priority":               "2",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccContextQuestionsDataSourceConfig_basic(label string) string {
+	return fmt.Sprintf(`
+resource "resourcely_context_question" "basic" {
+	prompt = "what is your prompt?"
+	qtype = "QTYPE_SINGLE_SELECT"
+	scope = "SCOPE_TENANT"
+	blueprint_categories = ["BLUEPRINT_BLOB_STORAGE"]
+	answer_choices = [{label: "tenant-context Option 1"}]
+	label = "%s"
+	priority = 2
+}
+
+data "resourcely_context_questions" "by_category" {
+  qtype = "QTYPE_SINGLE_SELECT"
+  blueprint_categories = ["BLUEPRINT_BLOB_STORAGE"]
+
+  depends_on = [resourcely_context_question.basic]
+}
+`, label)
+}
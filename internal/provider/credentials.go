@@ -0,0 +1,274 @@
+package provider
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Environment variables for the credential sources.
+const (
+	authTokenVar         = "RESOURCELY_AUTH_TOKEN"
+	authTokenFileVar     = "RESOURCELY_AUTH_TOKEN_FILE"
+	credentialProcessVar = "RESOURCELY_CREDENTIAL_PROCESS"
+	profileVar           = "RESOURCELY_PROFILE"
+	credentialsFileVar   = "RESOURCELY_CREDENTIALS_FILE"
+)
+
+const (
+	defaultProfile = "default"
+
+	// credentialProcessTimeout bounds how long a credential_process
+	// command may take to print a token.
+	credentialProcessTimeout = time.Minute
+)
+
+// defaultCredentialsFile returns ~/.resourcely/credentials, or an empty
+// string if the home directory is unknown.
+func defaultCredentialsFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".resourcely", "credentials")
+}
+
+// resolveAuthToken returns the auth token from the first credential
+// source that is set, and a description of that source for the logs.
+// The sources are, in order:
+//
+//  1. auth_token, auth_token_file, credential_process or profile in the
+//     provider block. At most one of them may be set.
+//  2. The RESOURCELY_AUTH_TOKEN, RESOURCELY_AUTH_TOKEN_FILE,
+//     RESOURCELY_CREDENTIAL_PROCESS and RESOURCELY_PROFILE environment
+//     variables, in that order.
+//  3. The "default" profile of the credentials file, if the file exists.
+//
+// It returns an empty token if no source is set.
+func resolveAuthToken(ctx context.Context, config ResourcelyProviderModel) (string, string, error) {
+	credentialsFile := stringSetting(config.CredentialsFile, credentialsFileVar)
+	if credentialsFile == "" {
+		credentialsFile = defaultCredentialsFile()
+	}
+
+	switch {
+	case !config.AuthToken.IsNull():
+		return config.AuthToken.ValueString(), "auth_token", nil
+	case !config.AuthTokenFile.IsNull():
+		token, err := readTokenFile(config.AuthTokenFile.ValueString())
+		return token, "auth_token_file", err
+	case !config.CredentialProcess.IsNull():
+		token, err := runCredentialProcess(ctx, config.CredentialProcess.ValueString())
+		return token, "credential_process", err
+	case !config.Profile.IsNull():
+		return profileAuthToken(ctx, credentialsFile, config.Profile.ValueString())
+	}
+
+	if token := os.Getenv(authTokenVar); token != "" {
+		return token, authTokenVar, nil
+	}
+	if file := os.Getenv(authTokenFileVar); file != "" {
+		token, err := readTokenFile(file)
+		return token, authTokenFileVar, err
+	}
+	if command := os.Getenv(credentialProcessVar); command != "" {
+		token, err := runCredentialProcess(ctx, command)
+		return token, credentialProcessVar, err
+	}
+	if profile := os.Getenv(profileVar); profile != "" {
+		return profileAuthToken(ctx, credentialsFile, profile)
+	}
+
+	if credentialsFile != "" {
+		if _, err := os.Stat(credentialsFile); err == nil {
+			profiles, err := readCredentialsFile(credentialsFile)
+			if err != nil {
+				return "", "", err
+			}
+			if _, ok := profiles[defaultProfile]; ok {
+				return profileAuthToken(ctx, credentialsFile, defaultProfile)
+			}
+		}
+	}
+
+	return "", "", nil
+}
+
+// readTokenFile reads an auth token from a file, ignoring surrounding
+// whitespace such as a trailing newline.
+func readTokenFile(file string) (string, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return "", fmt.Errorf("reading auth token file: %w", err)
+	}
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", fmt.Errorf("auth token file %s is empty", file)
+	}
+	return token, nil
+}
+
+// runCredentialProcess runs command and returns the token it prints on
+// stdout. The command is split into arguments like a shell would, but
+// is not run by a shell.
+func runCredentialProcess(ctx context.Context, command string) (string, error) {
+	args, err := splitCommand(command)
+	if err != nil {
+		return "", fmt.Errorf("parsing credential_process: %w", err)
+	}
+	if len(args) == 0 {
+		return "", fmt.Errorf("credential_process is empty")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, credentialProcessTimeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return "", fmt.Errorf("running credential_process %s: %w: %s", args[0], err, message)
+		}
+		return "", fmt.Errorf("running credential_process %s: %w", args[0], err)
+	}
+
+	token := strings.TrimSpace(stdout.String())
+	if token == "" {
+		return "", fmt.Errorf("credential_process %s printed no auth token", args[0])
+	}
+	return token, nil
+}
+
+// splitCommand splits a command line into arguments, honoring single
+// quotes, double quotes and backslash escapes.
+func splitCommand(command string) ([]string, error) {
+	var args []string
+	var arg strings.Builder
+	inArg := false
+	var quote rune
+	escaped := false
+
+	for _, r := range command {
+		switch {
+		case escaped:
+			arg.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'':
+			escaped = true
+			inArg = true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				arg.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote = r
+			inArg = true
+		case unicode.IsSpace(r):
+			if inArg {
+				args = append(args, arg.String())
+				arg.Reset()
+				inArg = false
+			}
+		default:
+			arg.WriteRune(r)
+			inArg = true
+		}
+	}
+
+	if quote != 0 || escaped {
+		return nil, fmt.Errorf("unterminated quote or escape in %q", command)
+	}
+	if inArg {
+		args = append(args, arg.String())
+	}
+	return args, nil
+}
+
+// profileAuthToken returns the auth token of a profile in the
+// credentials file. A profile sets one of auth_token, auth_token_file
+// or credential_process.
+func profileAuthToken(ctx context.Context, credentialsFile string, profile string) (string, string, error) {
+	source := fmt.Sprintf("profile %s", profile)
+	if credentialsFile == "" {
+		return "", source, fmt.Errorf("cannot find the credentials file for profile %s; set credentials_file", profile)
+	}
+
+	profiles, err := readCredentialsFile(credentialsFile)
+	if err != nil {
+		return "", source, err
+	}
+	settings, ok := profiles[profile]
+	if !ok {
+		return "", source, fmt.Errorf("profile %s not found in %s", profile, credentialsFile)
+	}
+
+	switch {
+	case settings["auth_token"] != "":
+		return settings["auth_token"], source, nil
+	case settings["auth_token_file"] != "":
+		token, err := readTokenFile(settings["auth_token_file"])
+		return token, source, err
+	case settings["credential_process"] != "":
+		token, err := runCredentialProcess(ctx, settings["credential_process"])
+		return token, source, err
+	default:
+		return "", source, fmt.Errorf("profile %s in %s sets none of auth_token, auth_token_file or credential_process", profile, credentialsFile)
+	}
+}
+
+// readCredentialsFile parses an INI-style credentials file into its
+// profiles:
+//
+//	[default]
+//	auth_token_file = /run/secrets/resourcely
+//
+//	[ci]
+//	credential_process = vault kv get -field=token secret/resourcely
+//
+// Lines starting with # or ; are comments.
+func readCredentialsFile(file string) (map[string]map[string]string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, fmt.Errorf("reading credentials file: %w", err)
+	}
+	defer f.Close()
+
+	profiles := map[string]map[string]string{}
+	var profile map[string]string
+
+	scanner := bufio.NewScanner(f)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";"):
+			continue
+		case strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]"):
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			if profiles[name] == nil {
+				profiles[name] = map[string]string{}
+			}
+			profile = profiles[name]
+		default:
+			key, value, ok := strings.Cut(line, "=")
+			if !ok || profile == nil {
+				return nil, fmt.Errorf("%s:%d: expected a [profile] header or a key = value setting", file, lineNumber)
+			}
+			profile[strings.TrimSpace(key)] = strings.TrimSpace(value)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading credentials file: %w", err)
+	}
+	return profiles, nil
+}
@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// clearCredentialEnv unsets every credential environment variable and
+// points the credentials file at a temporary path that does not exist.
+func clearCredentialEnv(t *testing.T) string {
+	for _, envVar := range []string{authTokenVar, authTokenFileVar, credentialProcessVar, profileVar} {
+		t.Setenv(envVar, "")
+	}
+	credentialsFile := filepath.Join(t.TempDir(), "credentials")
+	t.Setenv(credentialsFileVar, credentialsFile)
+	return credentialsFile
+}
+
+func writeFile(t *testing.T, file string, content string) string {
+	if err := os.WriteFile(file, []byte(content), 0o600); err != nil {
+		t.Fatalf("writing %s: %v", file, err)
+	}
+	return file
+}
+
+func TestResolveAuthToken_precedence(t *testing.T) {
+	ctx := context.Background()
+	credentialsFile := clearCredentialEnv(t)
+	tokenFile := writeFile(t, filepath.Join(t.TempDir(), "token"), "from-file\n")
+	writeFile(t, credentialsFile, `
+[default]
+auth_token = from-default-profile
+
+# Rotated by the platform team
+[ci]
+credential_process = echo "from-ci profile"
+`)
+
+	tests := []struct {
+		name   string
+		config ResourcelyProviderModel
+		env    map[string]string
+		token  string
+		source string
+	}{
+		{
+			name:   "default profile",
+			token:  "from-default-profile",
+			source: "profile default",
+		},
+		{
+			name:   "profile environment variable",
+			env:    map[string]string{profileVar: "ci"},
+			token:  "from-ci profile",
+			source: "profile ci",
+		},
+		{
+			name:   "credential process environment variable",
+			env:    map[string]string{profileVar: "ci", credentialProcessVar: "echo from-process"},
+			token:  "from-process",
+			source: credentialProcessVar,
+		},
+		{
+			name:   "token file environment variable",
+			env:    map[string]string{credentialProcessVar: "echo from-process", authTokenFileVar: tokenFile},
+			token:  "from-file",
+			source: authTokenFileVar,
+		},
+		{
+			name:   "token environment variable",
+			env:    map[string]string{authTokenFileVar: tokenFile, authTokenVar: "from-env"},
+			token:  "from-env",
+			source: authTokenVar,
+		},
+		{
+			name:   "profile attribute",
+			config: ResourcelyProviderModel{Profile: types.StringValue("ci")},
+			env:    map[string]string{authTokenVar: "from-env"},
+			token:  "from-ci profile",
+			source: "profile ci",
+		},
+		{
+			name:   "credential_process attribute",
+			config: ResourcelyProviderModel{CredentialProcess: types.StringValue("echo from-attribute")},
+			env:    map[string]string{authTokenVar: "from-env"},
+			token:  "from-attribute",
+			source: "credential_process",
+		},
+		{
+			name:   "auth_token_file attribute",
+			config: ResourcelyProviderModel{AuthTokenFile: types.StringValue(tokenFile)},
+			env:    map[string]string{authTokenVar: "from-env"},
+			token:  "from-file",
+			source: "auth_token_file",
+		},
+		{
+			name:   "auth_token attribute",
+			config: ResourcelyProviderModel{AuthToken: types.StringValue("from-attribute")},
+			env:    map[string]string{authTokenVar: "from-env"},
+			token:  "from-attribute",
+			source: "auth_token",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for envVar, value := range test.env {
+				t.Setenv(envVar, value)
+			}
+
+			token, source, err := resolveAuthToken(ctx, test.config)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if token != test.token || source != test.source {
+				t.Errorf("expected %q from %s, got %q from %s", test.token, test.source, token, source)
+			}
+		})
+	}
+}
+
+func TestResolveAuthToken_errors(t *testing.T) {
+	ctx := context.Background()
+	credentialsFile := clearCredentialEnv(t)
+
+	if token, _, err := resolveAuthToken(ctx, ResourcelyProviderModel{}); err != nil || token != "" {
+		t.Errorf("expected no token without a credentials file, got %q, %v", token, err)
+	}
+
+	writeFile(t, credentialsFile, "[ci]\nhost = https://example.com\n")
+	tests := []struct {
+		name   string
+		config ResourcelyProviderModel
+		err    string
+	}{
+		{"missing profile", ResourcelyProviderModel{Profile: types.StringValue("prod")}, "profile prod not found"},
+		{"profile without token", ResourcelyProviderModel{Profile: types.StringValue("ci")}, "sets none of"},
+		{"missing token file", ResourcelyProviderModel{AuthTokenFile: types.StringValue(filepath.Join(t.TempDir(), "missing"))}, "reading auth token file"},
+		{"empty token file", ResourcelyProviderModel{AuthTokenFile: types.StringValue(writeFile(t, filepath.Join(t.TempDir(), "empty"), "\n"))}, "is empty"},
+		{"failing process", ResourcelyProviderModel{CredentialProcess: types.StringValue("sh -c 'echo denied >&2; exit 1'")}, "denied"},
+		{"silent process", ResourcelyProviderModel{CredentialProcess: types.StringValue("true")}, "printed no auth token"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, _, err := resolveAuthToken(ctx, test.config)
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("expected an error containing %q, got %v", test.err, err)
+			}
+		})
+	}
+}
+
+func TestSplitCommand(t *testing.T) {
+	tests := map[string][]string{
+		"vault kv get -field=token secret/resourcely":  {"vault", "kv", "get", "-field=token", "secret/resourcely"},
+		`op read "op://Shared Vault/Resourcely/token"`: {"op", "read", "op://Shared Vault/Resourcely/token"},
+		`printf '%s' "a \"quoted\" token"`:             {"printf", "%s", `a "quoted" token`},
+		`echo spaced\ out ''`:                          {"echo", "spaced out", ""},
+	}
+	for command, expected := range tests {
+		args, err := splitCommand(command)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", command, err)
+			continue
+		}
+		if !reflect.DeepEqual(args, expected) {
+			t.Errorf("%s: expected %q, got %q", command, expected, args)
+		}
+	}
+
+	if _, err := splitCommand(`echo "unterminated`); err == nil {
+		t.Errorf("expected an error for an unterminated quote")
+	}
+}
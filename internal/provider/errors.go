@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/client"
+)
+
+// apiErrorDiagnostic builds the error diagnostic for a failed Resourcely
+// API call, adding a hint about what to do for the kind of error.
+func apiErrorDiagnostic(summary string, detail string, err error) diag.Diagnostic {
+	if hint := apiErrorHint(err); hint != "" {
+		detail += "\n\n" + hint
+	}
+	return diag.NewErrorDiagnostic(summary, detail)
+}
+
+func apiErrorHint(err error) string {
+	switch {
+	case client.IsReadOnly(err):
+		return "The provider is in read-only mode, so it refuses to create, update or delete anything. Unset read_only or RESOURCELY_READ_ONLY to apply changes."
+	case client.IsUnauthorized(err):
+		return "The Resourcely API rejected the auth token. Check that the provider's auth_token or RESOURCELY_AUTH_TOKEN is valid and has not expired."
+	case client.IsForbidden(err):
+		return "The auth token is not permitted to make this change in your Resourcely tenant."
+	case client.IsNotFound(err):
+		return "It may have been deleted outside of Terraform."
+	case client.IsConflict(err):
+		return "It conflicts with an existing entity in your Resourcely tenant, for example one with the same name, label or key."
+	case client.IsVersionMismatch(err):
+		return "It was changed outside of Terraform after Terraform last read it. Run terraform plan to review the changes, then apply again."
+	case client.IsValidation(err):
+		return "The Resourcely API rejected the configuration as invalid."
+	case client.IsRateLimited(err):
+		return "The Resourcely API is rate limiting requests. Wait a moment and try again."
+	case client.IsServerError(err):
+		return "The Resourcely API failed to handle the request. Try again, and contact Resourcely support with the request id if the problem persists."
+	default:
+		return ""
+	}
+}
+
+// Validation messages name the offending field before a colon, for
+// example "options[2].value: must be a number".
+var fieldErrorRegex = regexp.MustCompile(`^([A-Za-z_][A-Za-z0-9_]*(?:\[\d+\]|\.[A-Za-z0-9_]+)*)\s*:\s*(.+)$`)
+
+// apiPlanErrorDiagnostics builds the diagnostics for a failed Resourcely
+// API call that sent the plan. Each validation message that names a
+// field in the plan becomes an attribute error on that field. The
+// remaining messages fall back to a single resource-level error.
+//
+// apiFields renames API fields whose names differ from the schema, such
+// as "provider" to "cloud_provider".
+func apiPlanErrorDiagnostics(ctx context.Context, plan tfsdk.Plan, apiFields map[string]string, summary string, detail string, err error) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var errorResponse *client.ErrorResponse
+	if !client.IsValidation(err) || !errors.As(err, &errorResponse) || len(errorResponse.Err.Errors) == 0 {
+		diags.Append(apiErrorDiagnostic(summary, detail, err))
+		return diags
+	}
+
+	unmatched := false
+	for _, message := range errorResponse.Err.Errors {
+		match := fieldErrorRegex.FindStringSubmatch(message)
+		if match == nil {
+			unmatched = true
+			continue
+		}
+
+		attributePath, ok := planPathForAPIField(ctx, plan, renameAPIField(match[1], apiFields))
+		if !ok {
+			unmatched = true
+			continue
+		}
+
+		diags.AddAttributeError(attributePath, summary, "The Resourcely API rejected this value: "+match[2])
+	}
+
+	if unmatched {
+		diags.Append(apiErrorDiagnostic(summary, detail, err))
+	}
+
+	return diags
+}
+
+func renameAPIField(field string, apiFields map[string]string) string {
+	for apiField, attribute := range apiFields {
+		if field == apiField || strings.HasPrefix(field, apiField+".") || strings.HasPrefix(field, apiField+"[") {
+			return attribute + strings.TrimPrefix(field, apiField)
+		}
+	}
+	return field
+}
+
+// planPathForAPIField resolves an API field like "options[2].value" to
+// the matching path in the plan. Sets cannot be indexed, so errors
+// inside a set are attached to the whole set.
+func planPathForAPIField(ctx context.Context, plan tfsdk.Plan, field string) (path.Path, bool) {
+	steps := strings.FieldsFunc(field, func(r rune) bool { return r == '.' || r == '[' || r == ']' })
+	if len(steps) == 0 {
+		return path.Empty(), false
+	}
+
+	attributePath := path.Root(steps[0])
+	var value attr.Value
+	if diags := plan.GetAttribute(ctx, attributePath, &value); diags.HasError() {
+		return attributePath, false
+	}
+
+	for _, step := range steps[1:] {
+		switch current := value.(type) {
+		case types.Set:
+			return attributePath, true
+		case types.List:
+			index, err := strconv.Atoi(step)
+			if err != nil || index < 0 || index >= len(current.Elements()) {
+				return attributePath, true
+			}
+			attributePath = attributePath.AtListIndex(index)
+		default:
+			attributePath = attributePath.AtName(step)
+		}
+
+		if diags := plan.GetAttribute(ctx, attributePath, &value); diags.HasError() {
+			return attributePath.ParentPath(), true
+		}
+	}
+
+	return attributePath, true
+}
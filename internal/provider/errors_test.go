@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/client"
+)
+
+func testGlobalValuePlan(t *testing.T, ctx context.Context) tfsdk.Plan {
+	var schemaResp resource.SchemaResponse
+	NewGlobalValueResource().Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	plan := tfsdk.Plan{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+
+	model := GlobalValueLifecycleModel{
+		GlobalValueResourceModel: GlobalValueResourceModel{
+			Id:           types.StringUnknown(),
+			SeriesId:     types.StringUnknown(),
+			Version:      types.Int64Unknown(),
+			IsDeprecated: types.BoolValue(false),
+			Key:          types.StringValue("sizes"),
+			Name:         types.StringValue("Sizes"),
+			Description:  types.StringNull(),
+			Type:         types.StringValue("PRESET_VALUE_NUMBER"),
+		},
+		OnDestroy: types.StringValue(onDestroyAbandon),
+	}
+	for _, key := range []string{"small", "medium", "large"} {
+		model.Options = append(model.Options, GlobalValueOptionModel{
+			Key:         types.StringValue(key),
+			Label:       types.StringValue(key),
+			Description: types.StringNull(),
+			Value:       jsontypes.NewNormalizedValue(`"` + key + `"`),
+		})
+	}
+	if diags := plan.Set(ctx, &model); diags.HasError() {
+		t.Fatalf("setting plan: %v", diags)
+	}
+	return plan
+}
+
+func testValidationError(messages ...string) error {
+	return &client.ErrorResponse{
+		Response: &http.Response{
+			StatusCode: http.StatusBadRequest,
+			Request:    &http.Request{Method: http.MethodPost, URL: &url.URL{Path: "/api/v1/presets"}},
+		},
+		Err: client.Err{Status: http.StatusBadRequest, Errors: messages},
+	}
+}
+
+func TestApiPlanErrorDiagnostics_attributePaths(t *testing.T) {
+	ctx := context.Background()
+	plan := testGlobalValuePlan(t, ctx)
+
+	err := testValidationError(
+		"options[2].value: must be a number",
+		"preset_name: must not be empty",
+		"options.1.key: must be unique",
+		"options[7].value: must be a number",
+	)
+	diags := apiPlanErrorDiagnostics(ctx, plan, map[string]string{"preset_name": "name"}, "Error creating global value", err.Error(), err)
+
+	expected := []path.Path{
+		path.Root("options").AtListIndex(2).AtName("value"),
+		path.Root("name"),
+		path.Root("options").AtListIndex(1).AtName("key"),
+		path.Root("options"),
+	}
+	if len(diags) != len(expected) {
+		t.Fatalf("expected %d diagnostics, got %d: %v", len(expected), len(diags), diags)
+	}
+	for i, d := range diags {
+		withPath, ok := d.(interface{ Path() path.Path })
+		if !ok {
+			t.Errorf("diagnostic %d: expected an attribute error, got %v", i, d)
+			continue
+		}
+		if !withPath.Path().Equal(expected[i]) {
+			t.Errorf("diagnostic %d: expected path %s, got %s", i, expected[i], withPath.Path())
+		}
+	}
+}
+
+func TestApiPlanErrorDiagnostics_fallback(t *testing.T) {
+	ctx := context.Background()
+	plan := testGlobalValuePlan(t, ctx)
+
+	err := testValidationError("options[0].value: must be a number", "the request is invalid", "unknown_field: is wrong")
+	diags := apiPlanErrorDiagnostics(ctx, plan, nil, "Error creating global value", err.Error(), err)
+
+	if len(diags) != 2 {
+		t.Fatalf("expected an attribute error and a resource error, got %v", diags)
+	}
+	if _, ok := diags[1].(interface{ Path() path.Path }); ok {
+		t.Errorf("expected the unmatched messages to fall back to a resource error, got %v", diags[1])
+	}
+
+	conflict := &client.ErrorResponse{
+		Response: &http.Response{StatusCode: http.StatusConflict, Request: &http.Request{Method: http.MethodPost, URL: &url.URL{}}},
+		Err:      client.Err{Errors: []string{"key: a preset with this key already exists"}},
+	}
+	diags = apiPlanErrorDiagnostics(ctx, plan, nil, "Error creating global value", conflict.Error(), conflict)
+	if len(diags) != 1 {
+		t.Fatalf("expected a single resource error for a conflict, got %v", diags)
+	}
+	if _, ok := diags[0].(interface{ Path() path.Path }); ok {
+		t.Errorf("expected a resource error for a conflict, got %v", diags[0])
+	}
+}
@@ -7,12 +7,17 @@ import (
 	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
-var _ datasource.DataSource = &GlobalValueDataSource{}
+var (
+	_ datasource.DataSource                     = &GlobalValueDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &GlobalValueDataSource{}
+)
 
 func NewGlobalValueDataSource() datasource.DataSource {
 	return &GlobalValueDataSource{}
@@ -36,8 +41,9 @@ func (d *GlobalValueDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 				Computed:            true,
 			},
 			"series_id": schema.StringAttribute{
-				MarkdownDescription: "UUID for the global value.",
-				Required:            true,
+				MarkdownDescription: "UUID for the global value. Exactly one of `series_id` or `key` must be set.",
+				Optional:            true,
+				Computed:            true,
 			},
 			"version": schema.Int64Attribute{
 				MarkdownDescription: "Incrementing version number for the current version of the global value.",
@@ -48,7 +54,8 @@ func (d *GlobalValueDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 				Computed:            true,
 			},
 			"key": schema.StringAttribute{
-				MarkdownDescription: "An immutable identifier used to reference this global value in blueprints or guardrails.\n\nMust start with a lowercase letter in `a-z` and include only characters in `a-z0-9_`.",
+				MarkdownDescription: "An immutable identifier used to reference this global value in blueprints or guardrails.\n\nMust start with a lowercase letter in `a-z` and include only characters in `a-z0-9_`.\n\nExactly one of `series_id` or `key` must be set.",
+				Optional:            true,
 				Computed:            true,
 			},
 			"name": schema.StringAttribute{
@@ -112,7 +119,21 @@ func (d *GlobalValueDataSource) Configure(_ context.Context, req datasource.Conf
 	d.service = client.GlobalValues
 }
 
+func (d *GlobalValueDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("series_id"),
+			path.MatchRoot("key"),
+		),
+	}
+}
+
 func (d *GlobalValueDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.service == nil {
+		resp.Diagnostics.Append(unknownConfigDiagnostic())
+		return
+	}
+
 	// Read the config
 	var config GlobalValueResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
@@ -120,15 +141,39 @@ func (d *GlobalValueDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	globalValueSeriesId := config.SeriesId.ValueString()
-
-	globalValue, _, err := d.service.GetGlobalValueBySeriesId(ctx, globalValueSeriesId)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error reading global value",
-			"Could not read global value series id "+globalValueSeriesId+": "+err.Error(),
-		)
-		return
+	var globalValue *client.GlobalValue
+	var err error
+	if !config.SeriesId.IsNull() {
+		globalValueSeriesId := config.SeriesId.ValueString()
+
+		globalValue, _, err = d.service.GetGlobalValueBySeriesId(ctx, globalValueSeriesId)
+		if err != nil {
+			resp.Diagnostics.Append(apiErrorDiagnostic(
+				"Error reading global value",
+				"Could not read global value series id "+globalValueSeriesId+": "+err.Error(),
+				err,
+			))
+			return
+		}
+	} else {
+		globalValueKey := config.Key.ValueString()
+
+		globalValue, _, err = d.service.GetGlobalValueByKey(ctx, globalValueKey)
+		if err != nil {
+			resp.Diagnostics.Append(apiErrorDiagnostic(
+				"Error reading global value",
+				"Could not read global value key "+globalValueKey+": "+err.Error(),
+				err,
+			))
+			return
+		}
+		if globalValue == nil {
+			resp.Diagnostics.AddError(
+				"Global value not found",
+				"No global value has the key "+globalValueKey+".",
+			)
+			return
+		}
 	}
 
 	// Overwrite state with refreshed value
@@ -66,3 +66,49 @@ resource "resourcely_global_value" "basic_text" {
 }
 `, key)
 }
+
+func TestAccGlobalValueDataSource_byKey(t *testing.T) {
+	id := gonanoid.MustGenerate("abcdefghijklmnopqrstuvwxyz", 16)
+	key := "basic_text_" + id
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGlobalValueDataSourceConfig_byKey(key),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.resourcely_global_value.by_key", "series_id", "resourcely_global_value.basic_text", "series_id"),
+					resource.TestCheckResourceAttr("data.resourcely_global_value.by_key", "key", key),
+					resource.TestCheckResourceAttr("data.resourcely_global_value.by_key", "name", "Basic Text Test"),
+					resource.TestCheckResourceAttr("data.resourcely_global_value.by_key", "options.0.value", "\"option_0_value\""),
+				),
+			},
+		},
+	})
+}
+
+func testAccGlobalValueDataSourceConfig_byKey(key string) string {
+	return fmt.Sprintf(`
+resource "resourcely_global_value" "basic_text" {
+  key         = "%s"
+  name        = "Basic Text Test"
+  description = "This is a basic text test"
+
+  type    = "PRESET_VALUE_TEXT"
+  options = [
+    {
+      key         = "option_0"
+      label       = "Option 0"
+      description = "This is option 0"
+      value       = "\"option_0_value\""
+    }
+  ]
+}
+
+data "resourcely_global_value" "by_key" {
+  key = resourcely_global_value.basic_text.key
+}
+`, key)
+}
@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"regexp"
 
 	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/client"
@@ -11,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -24,8 +25,10 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces
 var (
-	_ resource.Resource                = &GlobalValueResource{}
-	_ resource.ResourceWithImportState = &GlobalValueResource{}
+	_ resource.Resource                   = &GlobalValueResource{}
+	_ resource.ResourceWithImportState    = &GlobalValueResource{}
+	_ resource.ResourceWithModifyPlan     = &GlobalValueResource{}
+	_ resource.ResourceWithValidateConfig = &GlobalValueResource{}
 )
 
 func NewGlobalValueResource() resource.Resource {
@@ -37,6 +40,30 @@ type GlobalValueResource struct {
 	service *client.GlobalValuesService
 }
 
+// GlobalValueLifecycleModel describes the resource data model. It adds
+// the attributes that only control what Terraform does with the global
+// value, which the data sources do not have.
+type GlobalValueLifecycleModel struct {
+	GlobalValueResourceModel
+
+	OnDestroy types.String `tfsdk:"on_destroy"`
+}
+
+// The JSON values that each type of global value takes for its
+// options.
+var globalValueOptionTypes = map[string]string{
+	"PRESET_VALUE_TEXT":   "a JSON string",
+	"PRESET_VALUE_NUMBER": "a JSON number",
+	"PRESET_VALUE_LIST":   "a JSON array of strings, numbers or booleans",
+	"PRESET_VALUE_OBJECT": "a JSON object",
+}
+
+// Values of the on_destroy attribute.
+const (
+	onDestroyDeprecate = "deprecate"
+	onDestroyAbandon   = "abandon"
+)
+
 func (r *GlobalValueResource) Metadata(
 	_ context.Context,
 	req resource.MetadataRequest,
@@ -51,7 +78,7 @@ func (r *GlobalValueResource) Schema(
 	resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "A [global value](https://docs.resourcely.io/concepts/other-features-and-settings/global-values) allows admins to define custom drop-downs for customizing Terraform infrastructure resource properties before they are provisioned.  They are useful for providing access to lists of relatively static values like VPC IDs, allowed regions, department or team names, etc.\n\nThis global value API does not support deletion. Deleting the Terraform resource will remove the resource from the Terraform state file, but will not actually delete the global value entity. Set `is_deprecated = true` to tell Resourcely that this global value should no longer be used by new blueprints or guardrails.",
+		MarkdownDescription: "A [global value](https://docs.resourcely.io/concepts/other-features-and-settings/global-values) allows admins to define custom drop-downs for customizing Terraform infrastructure resource properties before they are provisioned.  They are useful for providing access to lists of relatively static values like VPC IDs, allowed regions, department or team names, etc.\n\nThis global value API does not support deletion. Deleting the Terraform resource will remove the resource from the Terraform state file, but will not actually delete the global value entity. Set `is_deprecated = true` to tell Resourcely that this global value should no longer be used by new blueprints or guardrails, or set `on_destroy = \"deprecate\"` to deprecate it when the resource is destroyed.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				MarkdownDescription: "UUID for the current version of the global value.",
@@ -74,6 +101,15 @@ func (r *GlobalValueResource) Schema(
 				Computed:            true,
 				Optional:            true,
 			},
+			"on_destroy": schema.StringAttribute{
+				MarkdownDescription: "What to do with the global value when the resource is destroyed, since it cannot be deleted. Can be one of `deprecate`, to mark it deprecated so that new blueprints and guardrails stop using it, or `abandon`, to leave it unchanged. Defaults to `abandon`.",
+				Default:             stringdefault.StaticString(onDestroyAbandon),
+				Computed:            true,
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(onDestroyDeprecate, onDestroyAbandon),
+				},
+			},
 			"key": schema.StringAttribute{
 				MarkdownDescription: "An immutable identifier used to reference this global value in blueprints or guardrails. Must start with a lowercase letter in `a-z` and include only characters in `a-z0-9_`.",
 				Required:            true,
@@ -166,13 +202,123 @@ func (r *GlobalValueResource) Configure(
 	r.service = client.GlobalValues
 }
 
+// ValidateConfig checks that each option value matches the type of
+// the global value, and that option keys and labels are unique, so that
+// mistakes are reported at plan time instead of by the API at apply.
+func (r *GlobalValueResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var globalValueType types.String
+	var options types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("type"), &globalValueType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("options"), &options)...)
+	if resp.Diagnostics.HasError() || options.IsNull() || options.IsUnknown() {
+		return
+	}
+
+	keys := map[string]int{}
+	labels := map[string]int{}
+	for i, element := range options.Elements() {
+		object, ok := element.(types.Object)
+		if !ok || object.IsNull() || object.IsUnknown() {
+			continue
+		}
+		var option GlobalValueOptionModel
+		if diags := object.As(ctx, &option, basetypes.ObjectAsOptions{}); diags.HasError() {
+			continue
+		}
+		optionPath := path.Root("options").AtListIndex(i)
+
+		if first, ok := uniqueOptionField(keys, option.Key, i); !ok {
+			resp.Diagnostics.AddAttributeError(
+				optionPath.AtName("key"),
+				"Duplicate global value option key",
+				fmt.Sprintf("Option keys must be unique, but options[%d] and options[%d] both have the key %q.", first, i, option.Key.ValueString()),
+			)
+		}
+		if first, ok := uniqueOptionField(labels, option.Label, i); !ok {
+			resp.Diagnostics.AddAttributeError(
+				optionPath.AtName("label"),
+				"Duplicate global value option label",
+				fmt.Sprintf("Option labels must be unique, but options[%d] and options[%d] both have the label %q.", first, i, option.Label.ValueString()),
+			)
+		}
+
+		// The value's own type reports values that are not JSON
+		expected, ok := globalValueOptionTypes[globalValueType.ValueString()]
+		if !ok || option.Value.IsNull() || option.Value.IsUnknown() {
+			continue
+		}
+		var value interface{}
+		if diags := option.Value.Unmarshal(&value); diags.HasError() {
+			continue
+		}
+		if !optionValueMatchesType(globalValueType.ValueString(), value) {
+			resp.Diagnostics.AddAttributeError(
+				optionPath.AtName("value"),
+				"Invalid global value option",
+				fmt.Sprintf("The value of a %s global value must be %s, got %s.", globalValueType.ValueString(), expected, option.Value.ValueString()),
+			)
+		}
+	}
+}
+
+// uniqueOptionField records the index of the option with this key or
+// label. It returns the index of an earlier option with the same one,
+// and false, if there is one.
+func uniqueOptionField(seen map[string]int, field types.String, i int) (int, bool) {
+	if field.IsNull() || field.IsUnknown() {
+		return 0, true
+	}
+	if first, ok := seen[field.ValueString()]; ok {
+		return first, false
+	}
+	seen[field.ValueString()] = i
+	return 0, true
+}
+
+// optionValueMatchesType reports whether a decoded JSON option value
+// has the type that the global value type calls for.
+func optionValueMatchesType(globalValueType string, value interface{}) bool {
+	switch globalValueType {
+	case "PRESET_VALUE_TEXT":
+		_, ok := value.(string)
+		return ok
+	case "PRESET_VALUE_NUMBER":
+		_, ok := value.(float64)
+		return ok
+	case "PRESET_VALUE_LIST":
+		elements, ok := value.([]interface{})
+		if !ok {
+			return false
+		}
+		for _, element := range elements {
+			switch element.(type) {
+			case string, float64, bool:
+			default:
+				return false
+			}
+		}
+		return true
+	case "PRESET_VALUE_OBJECT":
+		_, ok := value.(map[string]interface{})
+		return ok
+	}
+	return true
+}
+
 func (r *GlobalValueResource) Create(
 	ctx context.Context,
 	req resource.CreateRequest,
 	resp *resource.CreateResponse,
 ) {
+	ctx, span := startResourceSpan(ctx, "resourcely_global_value", "Create")
+	defer endResourceSpan(ctx, span, &resp.State, &resp.Diagnostics)
+
 	// Get the plan
-	var plan GlobalValueResourceModel
+	var plan GlobalValueLifecycleModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -180,22 +326,41 @@ func (r *GlobalValueResource) Create(
 
 	// Create the resource
 	var newGlobalValue client.NewGlobalValue
-	resp.Diagnostics.Append(r.buildCommonFields(ctx, plan, &newGlobalValue.CommonGlobalValueFields)...)
+	resp.Diagnostics.Append(r.buildCommonFields(ctx, plan.GlobalValueResourceModel, &newGlobalValue.CommonGlobalValueFields)...)
 	newGlobalValue.Key = plan.Key.ValueString()
 	newGlobalValue.Type = plan.Type.ValueString()
+	newGlobalValue.IsDeprecated = plan.IsDeprecated.ValueBool()
 
 	globalValue, _, err := r.service.CreateGlobalValue(ctx, &newGlobalValue)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		globalValue = adoptCreated(ctx, err,
+			func(ctx context.Context) (*client.GlobalValue, error) {
+				globalValue, _, err := r.service.GetGlobalValueByKey(ctx, newGlobalValue.Key)
+				return globalValue, err
+			},
+			func(globalValue *client.GlobalValue) int64 { return globalValue.Version },
+			func(globalValue *client.GlobalValue) bool {
+				return globalValue.Type == newGlobalValue.Type && globalValue.Name == newGlobalValue.Name
+			},
+		)
+		if globalValue != nil {
+			resp.Diagnostics.Append(adoptedWarning("global value", globalValue.SeriesId, err))
+			err = nil
+		}
+	}
+	if err != nil {
+		resp.Diagnostics.Append(apiPlanErrorDiagnostics(
+			ctx, req.Plan, nil,
 			"Error creating global value",
 			"Could not create global value: "+err.Error(),
-		)
+			err,
+		)...)
 		return
 	}
 
 	// Set the resource state
-	var state GlobalValueResourceModel
-	resp.Diagnostics.Append(FlattenGlobalValue(globalValue, &state)...)
+	state := GlobalValueLifecycleModel{OnDestroy: plan.OnDestroy}
+	resp.Diagnostics.Append(FlattenGlobalValue(globalValue, &state.GlobalValueResourceModel)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -205,20 +370,34 @@ func (r *GlobalValueResource) Read(
 	req resource.ReadRequest,
 	resp *resource.ReadResponse,
 ) {
+	ctx, span := startResourceSpan(ctx, "resourcely_global_value", "Read")
+	defer endResourceSpan(ctx, span, &resp.State, &resp.Diagnostics)
+
+	if awaitingConfig(r.service) {
+		return
+	}
+
 	// Get the current state
-	var state GlobalValueResourceModel
+	var state GlobalValueLifecycleModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// State from provider versions before on_destroy has no value for
+	// it. They abandoned global values, so record that rather than
+	// planning a change to the default.
+	if state.OnDestroy.IsNull() {
+		state.OnDestroy = types.StringValue(onDestroyAbandon)
+	}
+
 	// Refresh value from the remote API
-	globalValue, httpResp, err := r.service.GetGlobalValueBySeriesId(
+	globalValue, _, err := r.service.GetGlobalValueBySeriesId(
 		ctx,
 		state.SeriesId.ValueString(),
 	)
 	if err != nil {
-		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+		if client.IsNotFound(err) {
 			resp.Diagnostics.AddWarning(
 				"Global value "+state.SeriesId.ValueString()+" was not found in Resourcely",
 				"The global value may have been deleted outside of Terraform",
@@ -226,16 +405,21 @@ func (r *GlobalValueResource) Read(
 			resp.State.RemoveResource(ctx)
 			return
 		} else {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(apiErrorDiagnostic(
 				"Error reading global value",
 				"Could not read global value series id "+state.SeriesId.ValueString()+": "+err.Error(),
-			)
+				err,
+			))
 			return
 		}
 	}
 
 	// Overwrite state with refreshed value
-	resp.Diagnostics.Append(FlattenGlobalValue(globalValue, &state)...)
+	prior := state
+	resp.Diagnostics.Append(FlattenGlobalValue(globalValue, &state.GlobalValueResourceModel)...)
+	if versionChanged(prior.Version, globalValue.Version) {
+		resp.Diagnostics.Append(driftDiagnostic("global value", globalValue.SeriesId, prior.Version.ValueInt64(), globalValue.Version, globalValue.UpdatedBy, changedAttributes(prior, state)))
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -244,60 +428,168 @@ func (r *GlobalValueResource) Update(
 	req resource.UpdateRequest,
 	resp *resource.UpdateResponse,
 ) {
+	ctx, span := startResourceSpan(ctx, "resourcely_global_value", "Update")
+	defer endResourceSpan(ctx, span, &resp.State, &resp.Diagnostics)
+
 	// Retrieve the plan and state
-	var plan GlobalValueResourceModel
-	var state GlobalValueResourceModel
+	var plan GlobalValueLifecycleModel
+	var state GlobalValueLifecycleModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	state.OnDestroy = plan.OnDestroy
+
+	// Changing only on_destroy does not need the API
+	if len(changedAttributes(state.GlobalValueResourceModel, plan.GlobalValueResourceModel)) == 0 {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+
+	resp.Diagnostics.Append(checkVersion(ctx, "global value", state.SeriesId.ValueString(), state.Version,
+		func(ctx context.Context) (*client.GlobalValue, error) {
+			globalValue, _, err := r.service.GetGlobalValueBySeriesId(ctx, state.SeriesId.ValueString())
+			return globalValue, err
+		},
+		func(globalValue *client.GlobalValue) int64 { return globalValue.Version },
+		func(globalValue *client.GlobalValue) []string {
+			current := state.GlobalValueResourceModel
+			if diags := FlattenGlobalValue(globalValue, &current); diags.HasError() {
+				return nil
+			}
+			return changedAttributes(state.GlobalValueResourceModel, current)
+		},
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Update the resource
 	var updatedGlobalValue client.UpdatedGlobalValue
 	updatedGlobalValue.SeriesId = state.SeriesId.ValueString()
-	resp.Diagnostics.Append(r.buildCommonFields(ctx, plan, &updatedGlobalValue.CommonGlobalValueFields)...)
+	updatedGlobalValue.Version = state.Version.ValueInt64()
+	updatedGlobalValue.IsDeprecated = plan.IsDeprecated.ValueBool()
+	resp.Diagnostics.Append(r.buildCommonFields(ctx, plan.GlobalValueResourceModel, &updatedGlobalValue.CommonGlobalValueFields)...)
 
 	globalValue, _, err := r.service.UpdateGlobalValue(ctx, &updatedGlobalValue)
+	if client.IsVersionMismatch(err) {
+		resp.Diagnostics.Append(r.versionConflict(ctx, state.GlobalValueResourceModel, err))
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apiPlanErrorDiagnostics(
+			ctx, req.Plan, nil,
 			"Error updating global value",
 			"Could not update global value series id "+state.SeriesId.ValueString()+": "+err.Error(),
-		)
+			err,
+		)...)
 		return
 	}
 
 	// Set the resource state
-	resp.Diagnostics.Append(FlattenGlobalValue(globalValue, &state)...)
+	resp.Diagnostics.Append(FlattenGlobalValue(globalValue, &state.GlobalValueResourceModel)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
-// Note: The presets API does not yet support deletion.
+// Note: The presets API does not yet support deletion. Depending on
+// on_destroy, the global value is deprecated or left as it is.
 func (r *GlobalValueResource) Delete(
 	ctx context.Context,
 	req resource.DeleteRequest,
 	resp *resource.DeleteResponse,
 ) {
+	ctx, span := startResourceSpan(ctx, "resourcely_global_value", "Delete")
+	defer endResourceSpan(ctx, span, &req.State, &resp.Diagnostics)
+
 	// Retrieve from state
-	var state *GlobalValueResourceModel
+	var state *GlobalValueLifecycleModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if state.OnDestroy.ValueString() == onDestroyDeprecate {
+		resp.Diagnostics.Append(r.deprecate(ctx, state.SeriesId.ValueString())...)
+		return
+	}
+
 	// Not implemented; the presets API does not support DELETE
 	resp.Diagnostics.AddWarning(
 		"Dropping global value from state without deleting",
-		"The global values API does not support deletion. Dropped global value series id "+state.SeriesId.ValueString()+" from the Terraform state anyway.",
+		"The global values API does not support deletion. Dropped global value series id "+state.SeriesId.ValueString()+" from the Terraform state anyway. "+
+			"Set on_destroy = \"deprecate\" to deprecate global values when they are destroyed.",
 	)
 }
 
+// deprecate marks the current version of the global value deprecated,
+// keeping any changes made outside of Terraform.
+func (r *GlobalValueResource) deprecate(ctx context.Context, seriesId string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	globalValue, _, err := r.service.GetGlobalValueBySeriesId(ctx, seriesId)
+	if err != nil {
+		// Nothing left to deprecate if it was already deleted outside of Terraform
+		if client.IsNotFound(err) {
+			return diags
+		}
+		diags.Append(apiErrorDiagnostic(
+			"Error deprecating global value",
+			"Could not read global value series id "+seriesId+": "+err.Error(),
+			err,
+		))
+		return diags
+	}
+	if globalValue.IsDeprecated {
+		return diags
+	}
+
+	_, _, err = r.service.UpdateGlobalValue(ctx, &client.UpdatedGlobalValue{
+		SeriesId:                seriesId,
+		Version:                 globalValue.Version,
+		CommonGlobalValueFields: globalValue.CommonGlobalValueFields,
+		IsDeprecated:            true,
+	})
+	if err != nil {
+		diags.Append(apiErrorDiagnostic(
+			"Error deprecating global value",
+			"Could not deprecate global value series id "+seriesId+": "+err.Error(),
+			err,
+		))
+	}
+	return diags
+}
+
 func (r *GlobalValueResource) ImportState(
 	ctx context.Context,
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
 	resource.ImportStatePassthroughID(ctx, path.Root("series_id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("on_destroy"), onDestroyAbandon)...)
+}
+
+func (r *GlobalValueResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// Nothing to keep when creating or destroying the global value
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan GlobalValueLifecycleModel
+	var state GlobalValueLifecycleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Changing on_destroy does not create a new version
+	newVersion := len(changedAttributes(state.GlobalValueResourceModel, plan.GlobalValueResourceModel)) > 0
+	resp.Diagnostics.Append(planVersionAttributes(ctx, req.State, &resp.Plan, newVersion)...)
 }
 
 func (r *GlobalValueResource) buildCommonFields(
@@ -329,3 +621,19 @@ func (r *GlobalValueResource) buildOption(ctx context.Context, plan GlobalValueO
 
 	return diags
 }
+
+// versionConflict describes an update that was rejected because the
+// global value changed in Resourcely after Terraform last read it.
+func (r *GlobalValueResource) versionConflict(ctx context.Context, state GlobalValueResourceModel, err error) diag.Diagnostic {
+	seriesId := state.SeriesId.ValueString()
+	expected := state.Version.ValueInt64()
+	globalValue, _, getErr := r.service.GetGlobalValueBySeriesId(ctx, seriesId)
+	if getErr != nil {
+		return versionConflictDiagnostic("global value", seriesId, expected, nil, nil, err)
+	}
+	current := state
+	if diags := FlattenGlobalValue(globalValue, &current); diags.HasError() {
+		return versionConflictDiagnostic("global value", seriesId, expected, &globalValue.Version, nil, err)
+	}
+	return versionConflictDiagnostic("global value", seriesId, expected, &globalValue.Version, changedAttributes(state, current), err)
+}
@@ -1,15 +1,27 @@
 package provider
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"os"
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
 	"github.com/matoous/go-nanoid/v2"
+
+	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/client"
+	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/fakeapi"
 )
 
 func importGlobalValueBySeriesId(global_valueName string) resource.ImportStateIdFunc {
@@ -99,6 +111,118 @@ resource "resourcely_global_value" "basic_text" {
 `, key, name)
 }
 
+func TestAccGlobalValueResource_deprecate(t *testing.T) {
+	if os.Getenv(hostnameVar) != "" || os.Getenv(authTokenVar) != "" {
+		t.Skip("Checking a destroyed global value needs the fake Resourcely API")
+	}
+	server := testAccUseFakeAPI(t)
+	id := gonanoid.MustGenerate("abcdefghijklmnopqrstuvwxyz", 16)
+	key := "deprecated_" + id
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlobalValueResourceConfig_deprecated(key, false, "abandon"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("resourcely_global_value.deprecated", "is_deprecated", "false"),
+					resource.TestCheckResourceAttr("resourcely_global_value.deprecated", "on_destroy", "abandon"),
+				),
+			},
+			// Changing on_destroy does not create a new version
+			{
+				Config: testAccGlobalValueResourceConfig_deprecated(key, false, "deprecate"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("resourcely_global_value.deprecated", "on_destroy", "deprecate"),
+					resource.TestCheckResourceAttr("resourcely_global_value.deprecated", "version", "1"),
+				),
+			},
+			{
+				Config: testAccGlobalValueResourceConfig_deprecated(key, true, "deprecate"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("resourcely_global_value.deprecated", "is_deprecated", "true"),
+					resource.TestCheckResourceAttr("resourcely_global_value.deprecated", "version", "2"),
+				),
+			},
+			{
+				Config: testAccGlobalValueResourceConfig_deprecated(key, false, "deprecate"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("resourcely_global_value.deprecated", "is_deprecated", "false"),
+				),
+			},
+			{
+				ResourceName:      "resourcely_global_value.deprecated",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: importGlobalValueBySeriesId("resourcely_global_value.deprecated"),
+				// Imports use the default on_destroy
+				ImportStateVerifyIgnore: []string{"on_destroy"},
+			},
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			c, err := client.NewClient(nil, server.URL, server.Token)
+			if err != nil {
+				return err
+			}
+			globalValue, _, err := c.GlobalValues.GetGlobalValueByKey(context.Background(), key)
+			if err != nil {
+				return err
+			}
+			if globalValue == nil || !globalValue.IsDeprecated {
+				return fmt.Errorf("expected the destroyed global value to be deprecated, got %+v", globalValue)
+			}
+			return nil
+		},
+	})
+}
+
+func TestAccGlobalValueResource_createDeprecated(t *testing.T) {
+	id := gonanoid.MustGenerate("abcdefghijklmnopqrstuvwxyz", 16)
+	key := "created_deprecated_" + id
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlobalValueResourceConfig_deprecated(key, true, "abandon"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("resourcely_global_value.deprecated", "is_deprecated", "true"),
+					resource.TestCheckResourceAttr("resourcely_global_value.deprecated", "version", "1"),
+				),
+			},
+			{
+				ResourceName:            "resourcely_global_value.deprecated",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       importGlobalValueBySeriesId("resourcely_global_value.deprecated"),
+				ImportStateVerifyIgnore: []string{"on_destroy"},
+			},
+		},
+	})
+}
+
+func testAccGlobalValueResourceConfig_deprecated(key string, isDeprecated bool, onDestroy string) string {
+	return fmt.Sprintf(`
+resource "resourcely_global_value" "deprecated" {
+  key           = "%s"
+  name          = "Deprecated"
+  is_deprecated = %t
+  on_destroy    = "%s"
+
+  type    = "PRESET_VALUE_TEXT"
+  options = [
+    {
+      key   = "option_0"
+      label = "Option 0"
+      value = "\"option_0_value\""
+    }
+  ]
+}
+`, key, isDeprecated, onDestroy)
+}
+
 func TestAccGlobalValueResource_basic_object(t *testing.T) {
 	id := gonanoid.MustGenerate("abcdefghijklmnopqrstuvwxyz", 16)
 	key := "basic_object_" + id
@@ -180,6 +304,178 @@ resource "resourcely_global_value" "basic_object" {
 `, key, name)
 }
 
+func TestGlobalValueResource_validateConfig(t *testing.T) {
+	ctx := context.Background()
+	r := &GlobalValueResource{}
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	type option struct {
+		key   string
+		label string
+		value jsontypes.Normalized
+	}
+	tests := map[string]struct {
+		globalValueType string
+		options         []option
+		want            []path.Path
+	}{
+		"matching values": {
+			globalValueType: "PRESET_VALUE_LIST",
+			options: []option{
+				{"a", "A", jsontypes.NewNormalizedValue(`["a", 1, true]`)},
+				{"b", "B", jsontypes.NewNormalizedValue(`[]`)},
+			},
+		},
+		"number": {
+			globalValueType: "PRESET_VALUE_NUMBER",
+			options: []option{
+				{"one", "One", jsontypes.NewNormalizedValue(`1`)},
+				{"two", "Two", jsontypes.NewNormalizedValue(`"two"`)},
+			},
+			want: []path.Path{path.Root("options").AtListIndex(1).AtName("value")},
+		},
+		"text": {
+			globalValueType: "PRESET_VALUE_TEXT",
+			options: []option{
+				{"one", "One", jsontypes.NewNormalizedValue(`1`)},
+			},
+			want: []path.Path{path.Root("options").AtListIndex(0).AtName("value")},
+		},
+		"list of objects": {
+			globalValueType: "PRESET_VALUE_LIST",
+			options: []option{
+				{"a", "A", jsontypes.NewNormalizedValue(`["a"]`)},
+				{"b", "B", jsontypes.NewNormalizedValue(`[{"b": 1}]`)},
+				{"c", "C", jsontypes.NewNormalizedValue(`"c"`)},
+			},
+			want: []path.Path{
+				path.Root("options").AtListIndex(1).AtName("value"),
+				path.Root("options").AtListIndex(2).AtName("value"),
+			},
+		},
+		"object": {
+			globalValueType: "PRESET_VALUE_OBJECT",
+			options: []option{
+				{"a", "A", jsontypes.NewNormalizedValue(`["a"]`)},
+			},
+			want: []path.Path{path.Root("options").AtListIndex(0).AtName("value")},
+		},
+		"unknown value": {
+			globalValueType: "PRESET_VALUE_NUMBER",
+			options: []option{
+				{"one", "One", jsontypes.NewNormalizedUnknown()},
+			},
+		},
+		"duplicates": {
+			globalValueType: "PRESET_VALUE_TEXT",
+			options: []option{
+				{"a", "A", jsontypes.NewNormalizedValue(`"a"`)},
+				{"b", "B", jsontypes.NewNormalizedValue(`"b"`)},
+				{"a", "B", jsontypes.NewNormalizedValue(`"c"`)},
+			},
+			want: []path.Path{
+				path.Root("options").AtListIndex(2).AtName("key"),
+				path.Root("options").AtListIndex(2).AtName("label"),
+			},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			model := GlobalValueLifecycleModel{
+				GlobalValueResourceModel: GlobalValueResourceModel{
+					Key:  types.StringValue("validated"),
+					Name: types.StringValue("Validated"),
+					Type: types.StringValue(test.globalValueType),
+				},
+			}
+			for _, o := range test.options {
+				model.Options = append(model.Options, GlobalValueOptionModel{
+					Key:   types.StringValue(o.key),
+					Label: types.StringValue(o.label),
+					Value: o.value,
+				})
+			}
+			// A config cannot be set directly, so set a plan and use its value
+			plan := tfsdk.Plan{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			}
+			if diags := plan.Set(ctx, &model); diags.HasError() {
+				t.Fatal(diags)
+			}
+
+			var resp fwresource.ValidateConfigResponse
+			r.ValidateConfig(ctx, fwresource.ValidateConfigRequest{Config: tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw}}, &resp)
+
+			var got []path.Path
+			for _, d := range resp.Diagnostics {
+				withPath, ok := d.(diag.DiagnosticWithPath)
+				if !ok {
+					t.Fatalf("expected an attribute error, got %v", d)
+				}
+				got = append(got, withPath.Path())
+			}
+			if len(got) != len(test.want) {
+				t.Fatalf("expected errors at %v, got %v", test.want, resp.Diagnostics)
+			}
+			for i := range got {
+				if !got[i].Equal(test.want[i]) {
+					t.Errorf("expected an error at %s, got %s", test.want[i], got[i])
+				}
+			}
+		})
+	}
+}
+
+func TestGlobalValueResource_readDefaultsOnDestroy(t *testing.T) {
+	ctx := context.Background()
+	server := fakeapi.NewServer()
+	t.Cleanup(server.Close)
+	c, err := client.NewClient(nil, server.URL, server.Token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	globalValue, _, err := c.GlobalValues.CreateGlobalValue(ctx, &client.NewGlobalValue{
+		CommonGlobalValueFields: client.CommonGlobalValueFields{Name: "upgraded"},
+		Key:                     "upgraded",
+		Type:                    "PRESET_VALUE_TEXT",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := &GlobalValueResource{service: c.GlobalValues}
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	// State written before on_destroy existed
+	model := GlobalValueLifecycleModel{OnDestroy: types.StringNull()}
+	if diags := FlattenGlobalValue(globalValue, &model.GlobalValueResourceModel); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if diags := state.Set(ctx, &model); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	resp := fwresource.ReadResponse{State: tfsdk.State{Schema: state.Schema, Raw: state.Raw.Copy()}}
+	r.Read(ctx, fwresource.ReadRequest{State: state}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+
+	var onDestroy types.String
+	if diags := resp.State.GetAttribute(ctx, path.Root("on_destroy"), &onDestroy); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if onDestroy.ValueString() != onDestroyAbandon {
+		t.Errorf("expected on_destroy %q, got %s", onDestroyAbandon, onDestroy)
+	}
+}
+
 func TestAccGlobalValueResource_errorNoOptions(t *testing.T) {
 	expectedErrors := []string{
 		"Attribute options list must contain at least 1 elements",
@@ -279,6 +575,43 @@ resource "resourcely_global_value" "error_invalid_json" {
 }
 `
 
+func TestAccGlobalValueResource_errorOptionValueType(t *testing.T) {
+	expectedErrors := []string{
+		"The value of a PRESET_VALUE_NUMBER global value must be a JSON number",
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               ErrorCheckExpectedErrorMessagesContaining(t, expectedErrors...),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccGlobalValueResourceConfig_errorOptionValueType,
+			},
+		},
+	})
+}
+
+const testAccGlobalValueResourceConfig_errorOptionValueType = `
+resource "resourcely_global_value" "error_option_value_type" {
+  key     = "error_option_value_type"
+  name    = "Error Option Value Type"
+  type    = "PRESET_VALUE_NUMBER"
+  options = [
+    {
+      key   = "option_0"
+      label = "Option 0"
+      value = "0"
+    },
+    {
+      key   = "option_1"
+      label = "Option 1"
+      value = "\"one\""
+    }
+  ]
+}
+`
+
 func ErrorCheckExpectedErrorMessagesContaining(t *testing.T, messages ...string) resource.ErrorCheckFunc {
 	return func(err error) error {
 		if err == nil {
@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &GlobalValuesDataSource{}
+
+func NewGlobalValuesDataSource() datasource.DataSource {
+	return &GlobalValuesDataSource{}
+}
+
+// GlobalValuesDataSource defines the data source implementation.
+type GlobalValuesDataSource struct {
+	service *client.GlobalValuesService
+}
+
+// GlobalValuesDataSourceModel describes the data source data model.
+type GlobalValuesDataSourceModel struct {
+	Id types.String `tfsdk:"id"`
+
+	Name         types.String `tfsdk:"name"`
+	Type         types.String `tfsdk:"type"`
+	IsDeprecated types.Bool   `tfsdk:"is_deprecated"`
+
+	GlobalValues []GlobalValueResourceModel `tfsdk:"global_values"`
+}
+
+func (d *GlobalValuesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_global_values"
+}
+
+func (d *GlobalValuesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the [global values](https://docs.resourcely.io/concepts/other-features-and-settings/global-values) in your Resourcely tenant that match all of the given filters. Omit every filter to list all global values.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "A placeholder identifier for this list.",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Only list the global values with exactly this name.",
+				Optional:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only list the global values of this type. One of `PRESET_VALUE_TEXT`, `PRESET_VALUE_NUMBER`, `PRESET_VALUE_LIST`, `PRESET_VALUE_OBJECT`.",
+				Optional:            true,
+			},
+			"is_deprecated": schema.BoolAttribute{
+				MarkdownDescription: "Only list the global values that are deprecated (`true`) or not deprecated (`false`).",
+				Optional:            true,
+			},
+			"global_values": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching global values.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "UUID for the current version of the global value.",
+							Computed:            true,
+						},
+						"series_id": schema.StringAttribute{
+							MarkdownDescription: "UUID for the global value.",
+							Computed:            true,
+						},
+						"version": schema.Int64Attribute{
+							MarkdownDescription: "Incrementing version number for the current version of the global value.",
+							Computed:            true,
+						},
+						"is_deprecated": schema.BoolAttribute{
+							MarkdownDescription: "Set to true if the global value should not be used in new blueprints or guardrails",
+							Computed:            true,
+						},
+						"key": schema.StringAttribute{
+							MarkdownDescription: "An immutable identifier used to reference this global value in blueprints or guardrails.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the global value.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "A description of the purpose of the global value.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of options in the global value. Will be one of `PRESET_VALUE_TEXT`, `PRESET_VALUE_NUMBER`, `PRESET_VALUE_LIST`, `PRESET_VALUE_OBJECT`",
+							Computed:            true,
+						},
+						"options": schema.ListNestedAttribute{
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"key": schema.StringAttribute{
+										MarkdownDescription: "An immutable identifier for ths option.",
+										Computed:            true,
+									},
+									"label": schema.StringAttribute{
+										MarkdownDescription: "A unique display name",
+										Computed:            true,
+									},
+									"description": schema.StringAttribute{
+										MarkdownDescription: "A description of this option's meaning.",
+										Computed:            true,
+									},
+									"value": schema.StringAttribute{
+										CustomType:          jsontypes.NormalizedType{},
+										MarkdownDescription: "A JSON encoding of the option's value.",
+										Computed:            true,
+									},
+								},
+							},
+							MarkdownDescription: "The list of value options for this global value.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *GlobalValuesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.service = client.GlobalValues
+}
+
+func (d *GlobalValuesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.service == nil {
+		resp.Diagnostics.Append(unknownConfigDiagnostic())
+		return
+	}
+
+	// Read the config
+	var config GlobalValuesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := &client.GlobalValueListOptions{
+		Name:         config.Name.ValueString(),
+		Type:         config.Type.ValueString(),
+		IsDeprecated: config.IsDeprecated.ValueBoolPointer(),
+	}
+
+	globalValues, err := d.service.ListGlobalValuesPaginator(opts).All(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Error listing global values",
+			"Could not list global values: "+err.Error(),
+			err,
+		))
+		return
+	}
+
+	// The API's name filter may not be exact
+	if opts.Name != "" {
+		globalValues = slices.DeleteFunc(globalValues, func(v client.GlobalValue) bool { return v.Name != opts.Name })
+	}
+
+	config.GlobalValues = make([]GlobalValueResourceModel, len(globalValues))
+	for i := range globalValues {
+		resp.Diagnostics.Append(FlattenGlobalValue(&globalValues[i], &config.GlobalValues[i])...)
+	}
+
+	config.Id = types.StringValue("global_values")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
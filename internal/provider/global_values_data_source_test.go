@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/matoous/go-nanoid/v2"
+)
+
+func TestAccGlobalValuesDataSource_basic(t *testing.T) {
+	id := gonanoid.MustGenerate("abcdefghijklmnopqrstuvwxyz", 16)
+	key := "basic_text_" + id
+	name := "Basic Text Test " + id
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGlobalValuesDataSourceConfig_basic(key, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.resourcely_global_values.by_name", "global_values.#", "1"),
+					resource.TestCheckResourceAttrPair("data.resourcely_global_values.by_name", "global_values.0.series_id", "resourcely_global_value.basic_text", "series_id"),
+					resource.TestCheckResourceAttr("data.resourcely_global_values.by_name", "global_values.0.key", key),
+					resource.TestCheckResourceAttr("data.resourcely_global_values.by_name", "global_values.0.is_deprecated", "false"),
+					resource.TestCheckResourceAttr("data.resourcely_global_values.by_name", "global_values.0.options.0.key", "option_0"),
+					resource.TestCheckResourceAttr("data.resourcely_global_values.by_name", "global_values.0.options.0.value", "\"option_0_value\""),
+					resource.TestCheckResourceAttr("data.resourcely_global_values.deprecated", "global_values.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGlobalValuesDataSourceConfig_basic(key, name string) string {
+	return fmt.Sprintf(`
+resource "resourcely_global_value" "basic_text" {
+  key         = "%s"
+  name        = "%s"
+  description = "This is a basic text test"
+
+  type    = "PRESET_VALUE_TEXT"
+  options = [
+    {
+      key         = "option_0"
+      label       = "Option 0"
+      description = "This is option 0"
+      value       = "\"option_0_value\""
+    }
+  ]
+}
+
+data "resourcely_global_values" "by_name" {
+  name          = resourcely_global_value.basic_text.name
+  type          = "PRESET_VALUE_TEXT"
+  is_deprecated = false
+}
+
+data "resourcely_global_values" "deprecated" {
+  name          = resourcely_global_value.basic_text.name
+  is_deprecated = true
+}
+`, key, name)
+}
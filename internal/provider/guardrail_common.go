@@ -61,3 +61,9 @@ func FlattenGuardrail(guardrail *client.Guardrail, data *GuardrailResourceModel)
 
 	return diags
 }
+
+// guardrailAPIFields maps guardrail API fields to their schema attributes.
+var guardrailAPIFields = map[string]string{
+	"provider":                     "cloud_provider",
+	"guardrail_template.series_id": "guardrail_template_series_id",
+}
@@ -7,12 +7,17 @@ import (
 	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/client"
 
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
-var _ datasource.DataSource = &GuardrailDataSource{}
+var (
+	_ datasource.DataSource                     = &GuardrailDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &GuardrailDataSource{}
+)
 
 func NewGuardrailDataSource() datasource.DataSource {
 	return &GuardrailDataSource{}
@@ -36,8 +41,9 @@ func (d *GuardrailDataSource) Schema(ctx context.Context, req datasource.SchemaR
 				Computed:            true,
 			},
 			"series_id": schema.StringAttribute{
-				MarkdownDescription: "UUID for the guardrail.",
-				Required:            true,
+				MarkdownDescription: "UUID for the guardrail. Exactly one of `series_id` or `name` must be set.",
+				Optional:            true,
+				Computed:            true,
 			},
 			"version": schema.Int64Attribute{
 				MarkdownDescription: "Incrementing version number for this current version of the guardrail.",
@@ -48,7 +54,8 @@ func (d *GuardrailDataSource) Schema(ctx context.Context, req datasource.SchemaR
 				Computed:            true,
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the guardrail.",
+				MarkdownDescription: "The name of the guardrail. Exactly one of `series_id` or `name` must be set. Fails unless exactly one guardrail has this name.",
+				Optional:            true,
 				Computed:            true,
 			},
 			"description": schema.StringAttribute{
@@ -104,7 +111,21 @@ func (d *GuardrailDataSource) Configure(ctx context.Context, req datasource.Conf
 	d.service = client.Guardrails
 }
 
+func (d *GuardrailDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("series_id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
 func (d *GuardrailDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.service == nil {
+		resp.Diagnostics.Append(unknownConfigDiagnostic())
+		return
+	}
+
 	// Read the config
 	var config GuardrailResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
@@ -112,15 +133,39 @@ func (d *GuardrailDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
-	guardrailSeriesId := config.SeriesId.ValueString()
-
-	guardrail, _, err := d.service.GetGuardrailBySeriesId(ctx, guardrailSeriesId)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error reading guardrail",
-			"Could not read guardrail id "+guardrailSeriesId+": "+err.Error(),
-		)
-		return
+	var guardrail *client.Guardrail
+	var err error
+	if !config.SeriesId.IsNull() {
+		guardrailSeriesId := config.SeriesId.ValueString()
+
+		guardrail, _, err = d.service.GetGuardrailBySeriesId(ctx, guardrailSeriesId)
+		if err != nil {
+			resp.Diagnostics.Append(apiErrorDiagnostic(
+				"Error reading guardrail",
+				"Could not read guardrail id "+guardrailSeriesId+": "+err.Error(),
+				err,
+			))
+			return
+		}
+	} else {
+		guardrailName := config.Name.ValueString()
+
+		guardrail, _, err = d.service.GetGuardrailByName(ctx, guardrailName)
+		if err != nil {
+			resp.Diagnostics.Append(apiErrorDiagnostic(
+				"Error reading guardrail",
+				"Could not read guardrail name "+guardrailName+": "+err.Error(),
+				err,
+			))
+			return
+		}
+		if guardrail == nil {
+			resp.Diagnostics.AddError(
+				"Guardrail not found",
+				"No guardrail has the name "+guardrailName+".",
+			)
+			return
+		}
 	}
 
 	// Overwrite state with refreshed value
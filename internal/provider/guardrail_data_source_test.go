@@ -1,9 +1,11 @@
 package provider
 
 import (
+	"fmt"
 	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
@@ -52,3 +54,88 @@ data "resourcely_guardrail" "by_series_id" {
   series_id = resourcely_guardrail.basic_data_source.series_id
 }
 `
+
+func TestAccGuardrailDataSource_byName(t *testing.T) {
+	name := "basic_test_" + acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGuardrailDataSourceConfig_byName(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.resourcely_guardrail.by_name", "series_id", "resourcely_guardrail.basic", "series_id"),
+					resource.TestCheckResourceAttr("data.resourcely_guardrail.by_name", "name", name),
+					resource.TestCheckResourceAttr("data.resourcely_guardrail.by_name", "state", "GUARDRAIL_STATE_EVALUATE_ONLY"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGuardrailDataSourceConfig_byName(name string) string {
+	return fmt.Sprintf(`
+resource "resourcely_guardrail" "basic" {
+  name = "%s"
+  cloud_provider = "PROVIDER_AMAZON"
+  category = "GUARDRAIL_BEST_PRACTICES"
+  state = "GUARDRAIL_STATE_EVALUATE_ONLY"
+  content = <<-EOT
+              GUARDRAIL "basic test"
+                WHEN aws_s3_bucket
+                  REQUIRE bucket = "acme-{team}-{project}"
+            EOT
+}
+
+data "resourcely_guardrail" "by_name" {
+  name = resourcely_guardrail.basic.name
+}
+`, name)
+}
+
+func TestAccGuardrailDataSource_errorsMultipleNameMatches(t *testing.T) {
+	name := "basic_test_" + acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ErrorCheck:               ErrorCheckExpectedErrorMessagesContaining(t, "Found multiple"),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "resourcely_guardrail" "first" {
+  name = "%[1]s"
+  cloud_provider = "PROVIDER_AMAZON"
+  category = "GUARDRAIL_BEST_PRACTICES"
+  state = "GUARDRAIL_STATE_INACTIVE"
+  content = <<-EOT
+              GUARDRAIL "basic test"
+                WHEN aws_s3_bucket
+                  REQUIRE bucket = "acme-{team}-{project}"
+            EOT
+}
+
+resource "resourcely_guardrail" "second" {
+  name = "%[1]s"
+  cloud_provider = "PROVIDER_AMAZON"
+  category = "GUARDRAIL_BEST_PRACTICES"
+  state = "GUARDRAIL_STATE_INACTIVE"
+  content = <<-EOT
+              GUARDRAIL "basic test"
+                WHEN aws_s3_bucket
+                  REQUIRE bucket = "acme-{team}-{project}"
+            EOT
+}
+
+data "resourcely_guardrail" "by_name" {
+  name = "%[1]s"
+
+  depends_on = [resourcely_guardrail.first, resourcely_guardrail.second]
+}
+`, name),
+			},
+		},
+	})
+}
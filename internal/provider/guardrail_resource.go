@@ -3,13 +3,13 @@ package provider
 import (
 	"context"
 	"fmt"
-	"net/http"
 
 	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/client"
 
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -23,6 +23,7 @@ import (
 var (
 	_ resource.Resource                = &GuardrailResource{}
 	_ resource.ResourceWithImportState = &GuardrailResource{}
+	_ resource.ResourceWithModifyPlan  = &GuardrailResource{}
 )
 
 func NewGuardrailResource() resource.Resource {
@@ -213,6 +214,9 @@ func (r *GuardrailResource) Create(
 	req resource.CreateRequest,
 	resp *resource.CreateResponse,
 ) {
+	ctx, span := startResourceSpan(ctx, "resourcely_guardrail", "Create")
+	defer endResourceSpan(ctx, span, &resp.State, &resp.Diagnostics)
+
 	// Get the plan
 	var plan GuardrailResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -239,10 +243,30 @@ func (r *GuardrailResource) Create(
 
 	guardrail, _, err := r.service.CreateGuardrail(ctx, newGuardrail)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		guardrail = adoptCreated(ctx, err,
+			func(ctx context.Context) (*client.Guardrail, error) {
+				guardrail, _, err := r.service.GetGuardrailByName(ctx, newGuardrail.Name)
+				return guardrail, err
+			},
+			func(guardrail *client.Guardrail) int64 { return guardrail.Version },
+			func(guardrail *client.Guardrail) bool {
+				return guardrail.Provider == newGuardrail.Provider &&
+					guardrail.Content == newGuardrail.Content &&
+					guardrail.GuardrailTemplate.SeriesId == newGuardrail.GuardrailTemplateSeriesId
+			},
+		)
+		if guardrail != nil {
+			resp.Diagnostics.Append(adoptedWarning("guardrail", guardrail.SeriesId, err))
+			err = nil
+		}
+	}
+	if err != nil {
+		resp.Diagnostics.Append(apiPlanErrorDiagnostics(
+			ctx, req.Plan, guardrailAPIFields,
 			"Error creating guardrail",
 			"Could not create guardrail, unexpected error: "+err.Error(),
-		)
+			err,
+		)...)
 		return
 	}
 
@@ -258,6 +282,13 @@ func (r *GuardrailResource) Read(
 	req resource.ReadRequest,
 	resp *resource.ReadResponse,
 ) {
+	ctx, span := startResourceSpan(ctx, "resourcely_guardrail", "Read")
+	defer endResourceSpan(ctx, span, &resp.State, &resp.Diagnostics)
+
+	if awaitingConfig(r.service) {
+		return
+	}
+
 	// Get the current state
 	var state GuardrailResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -266,12 +297,12 @@ func (r *GuardrailResource) Read(
 	}
 
 	// Refresh value from the remote API
-	guardrail, httpResp, err := r.service.GetGuardrailBySeriesId(
+	guardrail, _, err := r.service.GetGuardrailBySeriesId(
 		ctx,
 		state.SeriesId.ValueString(),
 	)
 	if err != nil {
-		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+		if client.IsNotFound(err) {
 			resp.Diagnostics.AddWarning(
 				"Guardrail "+state.SeriesId.ValueString()+" was not found in Resourcely",
 				"The guardrail may have been deleted outside of Terraform",
@@ -279,16 +310,21 @@ func (r *GuardrailResource) Read(
 			resp.State.RemoveResource(ctx)
 			return
 		} else {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(apiErrorDiagnostic(
 				"Error reading guardrail",
 				"Could not read guardrail series id "+state.SeriesId.ValueString()+": "+err.Error(),
-			)
+				err,
+			))
 			return
 		}
 	}
 
 	// Overwrite state with refreshed value
+	prior := state
 	resp.Diagnostics.Append(FlattenGuardrail(guardrail, &state)...)
+	if versionChanged(prior.Version, guardrail.Version) {
+		resp.Diagnostics.Append(driftDiagnostic("guardrail", guardrail.SeriesId, prior.Version.ValueInt64(), guardrail.Version, guardrail.UpdatedBy, changedAttributes(prior, state)))
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -297,6 +333,9 @@ func (r *GuardrailResource) Update(
 	req resource.UpdateRequest,
 	resp *resource.UpdateResponse,
 ) {
+	ctx, span := startResourceSpan(ctx, "resourcely_guardrail", "Update")
+	defer endResourceSpan(ctx, span, &resp.State, &resp.Diagnostics)
+
 	// Retrieve the plan and state
 	var plan GuardrailResourceModel
 	var state GuardrailResourceModel
@@ -306,9 +345,28 @@ func (r *GuardrailResource) Update(
 		return
 	}
 
+	resp.Diagnostics.Append(checkVersion(ctx, "guardrail", state.SeriesId.ValueString(), state.Version,
+		func(ctx context.Context) (*client.Guardrail, error) {
+			guardrail, _, err := r.service.GetGuardrailBySeriesId(ctx, state.SeriesId.ValueString())
+			return guardrail, err
+		},
+		func(guardrail *client.Guardrail) int64 { return guardrail.Version },
+		func(guardrail *client.Guardrail) []string {
+			current := state
+			if diags := FlattenGuardrail(guardrail, &current); diags.HasError() {
+				return nil
+			}
+			return changedAttributes(state, current)
+		},
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update the resource
 	updatedGuardrail := &client.UpdatedGuardrail{
 		SeriesId: state.SeriesId.ValueString(),
+		Version:  state.Version.ValueInt64(),
 		CommonGuardrailFields: client.CommonGuardrailFields{
 			Name:        plan.Name.ValueString(),
 			Description: plan.Description.ValueString(),
@@ -324,11 +382,17 @@ func (r *GuardrailResource) Update(
 	}
 
 	guardrail, _, err := r.service.UpdateGuardrail(ctx, updatedGuardrail)
+	if client.IsVersionMismatch(err) {
+		resp.Diagnostics.Append(r.versionConflict(ctx, state, err))
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.Append(apiPlanErrorDiagnostics(
+			ctx, req.Plan, guardrailAPIFields,
 			"Error updating guardrail",
 			"Could not update guardrail series id "+state.SeriesId.ValueString()+": "+err.Error(),
-		)
+			err,
+		)...)
 		return
 	}
 
@@ -342,6 +406,9 @@ func (r *GuardrailResource) Delete(
 	req resource.DeleteRequest,
 	resp *resource.DeleteResponse,
 ) {
+	ctx, span := startResourceSpan(ctx, "resourcely_guardrail", "Delete")
+	defer endResourceSpan(ctx, span, &req.State, &resp.Diagnostics)
+
 	// Retrieve from state
 	var state GuardrailResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -351,10 +418,16 @@ func (r *GuardrailResource) Delete(
 
 	_, err := r.service.DeleteGuardrail(ctx, state.SeriesId.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
+		// Nothing left to delete if it was already deleted outside of Terraform
+		if client.IsNotFound(err) {
+			return
+		}
+
+		resp.Diagnostics.Append(apiErrorDiagnostic(
 			"Error deleting guardrail",
 			"Could not delete guardrail series id "+state.SeriesId.ValueString()+": "+err.Error(),
-		)
+			err,
+		))
 		return
 	}
 }
@@ -366,3 +439,41 @@ func (r *GuardrailResource) ImportState(
 ) {
 	resource.ImportStatePassthroughID(ctx, path.Root("series_id"), req, resp)
 }
+
+func (r *GuardrailResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// Nothing to keep when creating or destroying the guardrail
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan GuardrailResourceModel
+	var state GuardrailResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newVersion := len(changedAttributes(state, plan)) > 0
+	resp.Diagnostics.Append(planVersionAttributes(ctx, req.State, &resp.Plan, newVersion)...)
+}
+
+// versionConflict describes an update that was rejected because the
+// guardrail changed in Resourcely after Terraform last read it.
+func (r *GuardrailResource) versionConflict(ctx context.Context, state GuardrailResourceModel, err error) diag.Diagnostic {
+	seriesId := state.SeriesId.ValueString()
+	expected := state.Version.ValueInt64()
+	guardrail, _, getErr := r.service.GetGuardrailBySeriesId(ctx, seriesId)
+	if getErr != nil {
+		return versionConflictDiagnostic("guardrail", seriesId, expected, nil, nil, err)
+	}
+	current := state
+	if diags := FlattenGuardrail(guardrail, &current); diags.HasError() {
+		return versionConflictDiagnostic("guardrail", seriesId, expected, &guardrail.Version, nil, err)
+	}
+	return versionConflictDiagnostic("guardrail", seriesId, expected, &guardrail.Version, changedAttributes(state, current), err)
+}
@@ -1,12 +1,17 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"os"
 	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/client"
 )
 
 func TestAccGuardrailResource_basic_withContent(t *testing.T) {
@@ -51,6 +56,144 @@ func TestAccGuardrailResource_basic_withContent(t *testing.T) {
 	})
 }
 
+func TestAccGuardrailResource_adoptsCommittedCreate(t *testing.T) {
+	if os.Getenv(hostnameVar) != "" || os.Getenv(authTokenVar) != "" {
+		t.Skip("Losing a create response needs the fake Resourcely API")
+	}
+	server := testAccUseFakeAPI(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() { server.LoseNextResponse(http.MethodPost) },
+				Config:    testAccGuardrailResourceConfig_basic_withContent("adopted"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("resourcely_guardrail.basic", "name", "adopted"),
+					func(s *terraform.State) error {
+						c, err := client.NewClient(nil, server.URL, server.Token)
+						if err != nil {
+							return err
+						}
+						// Fails if the create left a duplicate behind.
+						guardrail, _, err := c.Guardrails.GetGuardrailByName(context.Background(), "adopted")
+						if err != nil {
+							return err
+						}
+						return resource.TestCheckResourceAttr("resourcely_guardrail.basic", "series_id", guardrail.SeriesId)(s)
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccGuardrailResource_doesNotAdoptExisting(t *testing.T) {
+	if os.Getenv(hostnameVar) != "" || os.Getenv(authTokenVar) != "" {
+		t.Skip("Failing a create needs the fake Resourcely API")
+	}
+	server := testAccUseFakeAPI(t)
+
+	// createInPortal creates a guardrail identical to the configured
+	// one and edits it, as a colleague might in the Resourcely portal.
+	createInPortal := func() {
+		ctx := context.Background()
+		c, err := client.NewClient(nil, server.URL, server.Token)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fields := client.CommonGuardrailFields{
+			Name:        "existing",
+			Description: "this is a basic test",
+			Provider:    "PROVIDER_AMAZON",
+			Category:    "GUARDRAIL_BEST_PRACTICES",
+			State:       "GUARDRAIL_STATE_EVALUATE_ONLY",
+			Content:     "GUARDRAIL \"basic test\"\n  WHEN aws_s3_bucket\n    REQUIRE bucket = \"acme-{team}-{project}\"\n",
+		}
+		guardrail, _, err := c.Guardrails.CreateGuardrail(ctx, &client.NewGuardrail{CommonGuardrailFields: fields})
+		if err != nil {
+			t.Fatalf("creating guardrail: %v", err)
+		}
+		if _, _, err := c.Guardrails.UpdateGuardrail(ctx, &client.UpdatedGuardrail{SeriesId: guardrail.SeriesId, CommonGuardrailFields: fields}); err != nil {
+			t.Fatalf("editing guardrail: %v", err)
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					createInPortal()
+					server.FailNextRequest(http.MethodPost, http.StatusInternalServerError, "internal error")
+				},
+				Config:      testAccGuardrailResourceConfig_basic_withContent("existing"),
+				ExpectError: regexp.MustCompile("Could not create guardrail"),
+			},
+		},
+	})
+}
+
+func TestAccGuardrailResource_versionConflict(t *testing.T) {
+	if os.Getenv(hostnameVar) != "" || os.Getenv(authTokenVar) != "" {
+		t.Skip("Editing a guardrail between plan and apply needs the fake Resourcely API")
+	}
+	server := testAccUseFakeAPI(t)
+
+	// editInPortal changes the guardrail's description, as a colleague
+	// might in the Resourcely portal.
+	editInPortal := func() {
+		ctx := context.Background()
+		c, err := client.NewClient(nil, server.URL, server.Token)
+		if err != nil {
+			t.Fatal(err)
+		}
+		guardrail, _, err := c.Guardrails.GetGuardrailByName(ctx, "conflicted")
+		if err != nil || guardrail == nil {
+			t.Fatalf("finding guardrail: %v", err)
+		}
+		guardrail.Description = "edited in the portal"
+		_, _, err = c.Guardrails.UpdateGuardrail(ctx, &client.UpdatedGuardrail{
+			SeriesId:                  guardrail.SeriesId,
+			CommonGuardrailFields:     guardrail.CommonGuardrailFields,
+			GuardrailTemplateSeriesId: guardrail.GuardrailTemplate.SeriesId,
+			GuardrailTemplateInputs:   guardrail.GuardrailTemplateInputs,
+		})
+		if err != nil {
+			t.Fatalf("editing guardrail: %v", err)
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGuardrailResourceConfig_basic_withContent("conflicted"),
+			},
+			// The portal edit lands between the plan and the update
+			{
+				PreConfig: func() {
+					server.BeforeNextRequest(http.MethodPut, editInPortal)
+				},
+				Config:      testAccGuardrailResourceConfig_basic_withContent("renamed"),
+				ExpectError: regexp.MustCompile(`expected version 1,\s+but Resourcely is at version 2\.\s+Changed attributes: description\.`),
+			},
+			// Once refreshed, the plan shows the portal edit to be reverted
+			{
+				Config: testAccGuardrailResourceConfig_basic_withContent("renamed"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("resourcely_guardrail.basic", "description", "this is a basic test"),
+					resource.TestCheckResourceAttr("resourcely_guardrail.basic", "name", "renamed"),
+					resource.TestCheckResourceAttr("resourcely_guardrail.basic", "version", "3"),
+				),
+			},
+		},
+	})
+}
+
 func importGuardrailBySeriesId(guardrailName string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		guardrail := s.RootModule().Resources[guardrailName]
@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &GuardrailsDataSource{}
+
+func NewGuardrailsDataSource() datasource.DataSource {
+	return &GuardrailsDataSource{}
+}
+
+// GuardrailsDataSource defines the data source implementation.
+type GuardrailsDataSource struct {
+	service *client.GuardrailsService
+}
+
+// GuardrailsDataSourceModel describes the data source data model.
+type GuardrailsDataSourceModel struct {
+	Id types.String `tfsdk:"id"`
+
+	Name       types.String `tfsdk:"name"`
+	Provider   types.String `tfsdk:"cloud_provider"`
+	Categories types.Set    `tfsdk:"categories"`
+	States     types.Set    `tfsdk:"states"`
+
+	Guardrails []GuardrailResourceModel `tfsdk:"guardrails"`
+}
+
+func (d *GuardrailsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_guardrails"
+}
+
+func (d *GuardrailsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the [guardrails](https://docs.resourcely.io/build/setting-up-guardrails) in your Resourcely tenant that match all of the given filters. Omit every filter to list all guardrails.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "A placeholder identifier for this list.",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Only list the guardrails with exactly this name.",
+				Optional:            true,
+			},
+			"cloud_provider": schema.StringAttribute{
+				MarkdownDescription: "Only list the guardrails that target this cloud provider.",
+				Optional:            true,
+			},
+			"categories": schema.SetAttribute{
+				ElementType:         basetypes.StringType{},
+				MarkdownDescription: "Only list the guardrails in any of these categories.",
+				Optional:            true,
+			},
+			"states": schema.SetAttribute{
+				ElementType:         basetypes.StringType{},
+				MarkdownDescription: "Only list the guardrails in any of these [states](https://docs.resourcely.io/build/setting-up-guardrails/releasing-guardrails#guardrail-status).",
+				Optional:            true,
+			},
+			"guardrails": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching guardrails.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "UUID for the current version of the guardrail.",
+							Computed:            true,
+						},
+						"series_id": schema.StringAttribute{
+							MarkdownDescription: "UUID for the guardrail.",
+							Computed:            true,
+						},
+						"version": schema.Int64Attribute{
+							MarkdownDescription: "Incrementing version number for this current version of the guardrail.",
+							Computed:            true,
+						},
+						"scope": schema.StringAttribute{
+							MarkdownDescription: "",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the guardrail.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "A description of the guardrail's purpose or policy.",
+							Computed:            true,
+						},
+						"cloud_provider": schema.StringAttribute{
+							MarkdownDescription: "The cloud provider that this guardrail targets.",
+							Computed:            true,
+						},
+						"category": schema.StringAttribute{
+							MarkdownDescription: "The category of this guardrail.",
+							Computed:            true,
+						},
+						"state": schema.StringAttribute{
+							MarkdownDescription: "The [state](https://docs.resourcely.io/build/setting-up-guardrails/releasing-guardrails#guardrail-status) of the guardrail.",
+							Computed:            true,
+						},
+						"content": schema.StringAttribute{
+							MarkdownDescription: "The guardrail policy written in the [Really policy language](https://docs.resourcely.io/build/setting-up-guardrails/authoring-your-own-guardrails).",
+							Computed:            true,
+						},
+						"guardrail_template_series_id": schema.StringAttribute{
+							MarkdownDescription: "The series id of the guardrail template used to render the policy.",
+							Computed:            true,
+						},
+						"guardrail_template_inputs": schema.StringAttribute{
+							CustomType:          jsontypes.NormalizedType{},
+							MarkdownDescription: "A JSON encoding of values for the guardrail template inputs.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *GuardrailsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.service = client.Guardrails
+}
+
+func (d *GuardrailsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.service == nil {
+		resp.Diagnostics.Append(unknownConfigDiagnostic())
+		return
+	}
+
+	// Read the config
+	var config GuardrailsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := &client.GuardrailListOptions{
+		Name:     config.Name.ValueString(),
+		Provider: config.Provider.ValueString(),
+	}
+	if !config.Categories.IsNull() {
+		resp.Diagnostics.Append(config.Categories.ElementsAs(ctx, &opts.Categories, false)...)
+	}
+	if !config.States.IsNull() {
+		resp.Diagnostics.Append(config.States.ElementsAs(ctx, &opts.States, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	guardrails, err := d.service.ListGuardrailsPaginator(opts).All(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Error listing guardrails",
+			"Could not list guardrails: "+err.Error(),
+			err,
+		))
+		return
+	}
+
+	// The API's name filter may not be exact
+	if opts.Name != "" {
+		guardrails = slices.DeleteFunc(guardrails, func(g client.Guardrail) bool { return g.Name != opts.Name })
+	}
+
+	config.Guardrails = make([]GuardrailResourceModel, len(guardrails))
+	for i := range guardrails {
+		resp.Diagnostics.Append(FlattenGuardrail(&guardrails[i], &config.Guardrails[i])...)
+	}
+
+	config.Id = types.StringValue("guardrails")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/client"
+)
+
+func TestAccGuardrailsDataSource_basic(t *testing.T) {
+	name := "basic_test_" + acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccGuardrailsDataSourceConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.resourcely_guardrails.by_state", "guardrails.#", "1"),
+					resource.TestCheckResourceAttrPair("data.resourcely_guardrails.by_state", "guardrails.0.series_id", "resourcely_guardrail.evaluate_only", "series_id"),
+					resource.TestCheckResourceAttr("data.resourcely_guardrails.by_state", "guardrails.0.name", name),
+					resource.TestCheckResourceAttr("data.resourcely_guardrails.by_state", "guardrails.0.category", "GUARDRAIL_BEST_PRACTICES"),
+					resource.TestCheckResourceAttr("data.resourcely_guardrails.by_state", "guardrails.0.state", "GUARDRAIL_STATE_EVALUATE_ONLY"),
+					resource.TestCheckResourceAttr("data.resourcely_guardrails.by_name", "guardrails.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestGuardrailsDataSource_matchesNameExactly(t *testing.T) {
+	ctx := context.Background()
+	// Like an API that matches names by substring
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", client.MediaTypeJSON)
+		_ = json.NewEncoder(w).Encode(client.QueryResponse[client.Guardrail]{
+			Page:       1,
+			TotalPages: 1,
+			TotalItems: 2,
+			PageItems: []client.Guardrail{
+				{SeriesId: "1", CommonGuardrailFields: client.CommonGuardrailFields{Name: "network"}},
+				{SeriesId: "2", CommonGuardrailFields: client.CommonGuardrailFields{Name: "network-extra"}},
+			},
+		})
+	}))
+	t.Cleanup(server.Close)
+	c, err := client.NewClient(nil, server.URL, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := &GuardrailsDataSource{service: c.Guardrails}
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+	config := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	if diags := config.Set(ctx, &GuardrailsDataSourceModel{
+		Name:       types.StringValue("network"),
+		Categories: types.SetNull(types.StringType),
+		States:     types.SetNull(types.StringType),
+	}); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	resp := datasource.ReadResponse{State: tfsdk.State{Schema: config.Schema, Raw: config.Raw.Copy()}}
+	d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: config.Schema, Raw: config.Raw}}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+
+	var state GuardrailsDataSourceModel
+	if diags := resp.State.Get(ctx, &state); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if len(state.Guardrails) != 1 || state.Guardrails[0].SeriesId.ValueString() != "1" {
+		t.Errorf("expected only the guardrail named exactly network, got %+v", state.Guardrails)
+	}
+}
+
+func testAccGuardrailsDataSourceConfig_basic(name string) string {
+	return fmt.Sprintf(`
+resource "resourcely_guardrail" "evaluate_only" {
+  name = "%[1]s"
+  cloud_provider = "PROVIDER_AMAZON"
+  category = "GUARDRAIL_BEST_PRACTICES"
+  state = "GUARDRAIL_STATE_EVALUATE_ONLY"
+  content = <<-EOT
+              GUARDRAIL "basic test"
+                WHEN aws_s3_bucket
+                  REQUIRE bucket = "acme-{team}-{project}"
+            EOT
+}
+
+resource "resourcely_guardrail" "inactive" {
+  name = "%[1]s"
+  cloud_provider = "PROVIDER_AMAZON"
+  category = "GUARDRAIL_BEST_PRACTICES"
+  state = "GUARDRAIL_STATE_INACTIVE"
+  content = <<-EOT
+              GUARDRAIL "basic test"
+                WHEN aws_s3_bucket
+                  REQUIRE bucket = "acme-{team}-{project}"
+            EOT
+}
+
+data "resourcely_guardrails" "by_state" {
+  name = "%[1]s"
+  categories = ["GUARDRAIL_BEST_PRACTICES"]
+  states = ["GUARDRAIL_STATE_EVALUATE_ONLY"]
+
+  depends_on = [resourcely_guardrail.evaluate_only, resourcely_guardrail.inactive]
+}
+
+data "resourcely_guardrails" "by_name" {
+  name = "%[1]s"
+
+  depends_on = [resourcely_guardrail.evaluate_only, resourcely_guardrail.inactive]
+}
+`, name)
+}
@@ -2,13 +2,17 @@ package provider
 
 import (
 	"context"
-	"fmt"
 	"os"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/providervalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 
 	//	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -19,11 +23,17 @@ import (
 
 const (
 	DEFAULT_HOST = "https://api.resourcely.io"
+
+	hostnameVar        = "RESOURCELY_HOST"
+	skipHealthCheckVar = "RESOURCELY_SKIP_HEALTH_CHECK"
+	readOnlyVar        = "RESOURCELY_READ_ONLY"
+	auditLogPathVar    = "RESOURCELY_AUDIT_LOG_PATH"
 )
 
 // Ensure ResourcelyProvider satisfies various provider interfaces.
 var (
-	_ provider.Provider = &ResourcelyProvider{}
+	_ provider.Provider                     = &ResourcelyProvider{}
+	_ provider.ProviderWithConfigValidators = &ResourcelyProvider{}
 )
 
 // ResourcelyProvider defines the provider implementation.
@@ -39,6 +49,30 @@ type ResourcelyProviderModel struct {
 	Host           types.String `tfsdk:"host"`
 	AuthToken      types.String `tfsdk:"auth_token"`
 	AllowedTenants types.List   `tfsdk:"allowed_tenants"`
+
+	DeniedTenants      types.List `tfsdk:"denied_tenants"`
+	RequireTenantClaim types.Bool `tfsdk:"require_tenant_claim"`
+
+	AuthTokenFile     types.String `tfsdk:"auth_token_file"`
+	CredentialProcess types.String `tfsdk:"credential_process"`
+	Profile           types.String `tfsdk:"profile"`
+	CredentialsFile   types.String `tfsdk:"credentials_file"`
+
+	TokenExpiryWarning types.String `tfsdk:"token_expiry_warning"`
+
+	SkipHealthCheck types.Bool `tfsdk:"skip_health_check"`
+	ReadOnly        types.Bool `tfsdk:"read_only"`
+
+	AuditLogPath types.String `tfsdk:"audit_log_path"`
+
+	RequestTimeout types.String `tfsdk:"request_timeout"`
+	MaxRetries     types.Int64  `tfsdk:"max_retries"`
+	RetryWaitMin   types.String `tfsdk:"retry_wait_min"`
+	RetryWaitMax   types.String `tfsdk:"retry_wait_max"`
+	ProxyURL       types.String `tfsdk:"proxy_url"`
+	CABundleFile   types.String `tfsdk:"ca_bundle_file"`
+	ClientCertFile types.String `tfsdk:"client_cert_file"`
+	ClientKeyFile  types.String `tfsdk:"client_key_file"`
 }
 
 func (p *ResourcelyProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -59,15 +93,106 @@ func (p *ResourcelyProvider) Schema(ctx context.Context, req provider.SchemaRequ
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"auth_token_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file containing the authorization token. Can also be set with the `RESOURCELY_AUTH_TOKEN_FILE` environment variable.",
+				Optional:            true,
+			},
+			"credential_process": schema.StringAttribute{
+				MarkdownDescription: "Command that prints the authorization token on stdout, for example a secrets manager CLI. The command is split into arguments like a shell would, but is not run by a shell. Can also be set with the `RESOURCELY_CREDENTIAL_PROCESS` environment variable.",
+				Optional:            true,
+			},
+			"profile": schema.StringAttribute{
+				MarkdownDescription: "Name of the profile in the credentials file to read the authorization token from. Can also be set with the `RESOURCELY_PROFILE` environment variable.",
+				Optional:            true,
+			},
+			"credentials_file": schema.StringAttribute{
+				MarkdownDescription: "Path to the credentials file. Defaults to `~/.resourcely/credentials`. Can also be set with the `RESOURCELY_CREDENTIALS_FILE` environment variable.",
+				Optional:            true,
+			},
+			"token_expiry_warning": schema.StringAttribute{
+				MarkdownDescription: "How long before the authorization token expires to start warning about it, as a duration such as `72h`. Defaults to `168h`. Can also be set with the `RESOURCELY_TOKEN_EXPIRY_WARNING` environment variable.",
+				Optional:            true,
+			},
 			"allowed_tenants": schema.ListAttribute{
 				ElementType:         types.StringType,
-				MarkdownDescription: "List of allowed tenant names (case-insensitive) to prevent accidently applying a configuration to the wrong one.",
+				MarkdownDescription: "List of allowed tenant names (case-insensitive) to prevent accidently applying a configuration to the wrong one. Each entry may be a glob such as `acme-*`, or a regular expression between slashes such as `/^acme-(dev|staging)$/`.",
+				Optional:            true,
+			},
+			"denied_tenants": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "List of denied tenant names (case-insensitive), such as your production tenant. Takes precedence over `allowed_tenants`, and accepts the same globs and regular expressions.",
+				Optional:            true,
+			},
+			"require_tenant_claim": schema.BoolAttribute{
+				MarkdownDescription: "Reject an authorization token without a tenant claim. Tokens without one are always rejected when `allowed_tenants` or `denied_tenants` is set.",
+				Optional:            true,
+			},
+			"skip_health_check": schema.BoolAttribute{
+				MarkdownDescription: "Skip checking that the Resourcely API is healthy when the provider starts, for offline workflows such as `terraform plan -refresh=false` or state moves. Defaults to `false`. Can also be set with the `RESOURCELY_SKIP_HEALTH_CHECK` environment variable.",
+				Optional:            true,
+			},
+			"read_only": schema.BoolAttribute{
+				MarkdownDescription: "Refuse every request that could change Resourcely, so that refreshes, plans and data sources work but an apply fails before changing anything. Defaults to `false`. Can also be set with the `RESOURCELY_READ_ONLY` environment variable.",
+				Optional:            true,
+			},
+			"audit_log_path": schema.StringAttribute{
+				MarkdownDescription: "Path to a file that the provider appends a JSON line to for every create, update and delete it sends to Resourcely, with the entity's series id, its version before and after, the request id and the request body with secrets redacted. The file is created if it does not exist. Can also be set with the `RESOURCELY_AUDIT_LOG_PATH` environment variable.",
+				Optional:            true,
+			},
+			"request_timeout": schema.StringAttribute{
+				MarkdownDescription: "Timeout for each attempt of a request to the Resourcely API, as a duration such as `30s`. Defaults to `60s`. Can also be set with the `RESOURCELY_REQUEST_TIMEOUT` environment variable.",
+				Optional:            true,
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "Number of times a failed request is retried. Defaults to 4. Set to 0 to disable retries. Can also be set with the `RESOURCELY_MAX_RETRIES` environment variable.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_wait_min": schema.StringAttribute{
+				MarkdownDescription: "Shortest wait before retrying a request, as a duration such as `1s`. Defaults to `1s`. Can also be set with the `RESOURCELY_RETRY_WAIT_MIN` environment variable.",
+				Optional:            true,
+			},
+			"retry_wait_max": schema.StringAttribute{
+				MarkdownDescription: "Longest wait before retrying a request, including waits requested by the API's `Retry-After` header. Defaults to `30s`. Can also be set with the `RESOURCELY_RETRY_WAIT_MAX` environment variable.",
+				Optional:            true,
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the HTTP proxy for requests to the Resourcely API. Defaults to the standard `HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY` environment variables. Can also be set with the `RESOURCELY_PROXY_URL` environment variable.",
+				Optional:            true,
+			},
+			"ca_bundle_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a PEM file of certificate authorities to trust in addition to the system ones, for example those of a TLS-intercepting proxy or a self-hosted Resourcely. Can also be set with the `RESOURCELY_CA_BUNDLE_FILE` environment variable.",
+				Optional:            true,
+			},
+			"client_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a PEM client certificate to present for mutual TLS. Requires `client_key_file`. Can also be set with the `RESOURCELY_CLIENT_CERT_FILE` environment variable.",
+				Optional:            true,
+			},
+			"client_key_file": schema.StringAttribute{
+				MarkdownDescription: "Path to the PEM private key of `client_cert_file`. Can also be set with the `RESOURCELY_CLIENT_KEY_FILE` environment variable.",
 				Optional:            true,
 			},
 		},
 	}
 }
 
+func (p *ResourcelyProvider) ConfigValidators(ctx context.Context) []provider.ConfigValidator {
+	return []provider.ConfigValidator{
+		providervalidator.Conflicting(
+			path.MatchRoot("auth_token"),
+			path.MatchRoot("auth_token_file"),
+			path.MatchRoot("credential_process"),
+			path.MatchRoot("profile"),
+		),
+		providervalidator.RequiredTogether(
+			path.MatchRoot("client_cert_file"),
+			path.MatchRoot("client_key_file"),
+		),
+	}
+}
+
 func (p *ResourcelyProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	tflog.Info(ctx, "Configuring Resourcely client")
 
@@ -79,20 +204,59 @@ func (p *ResourcelyProvider) Configure(ctx context.Context, req provider.Configu
 		return
 	}
 
-	host := os.Getenv("RESOURCELY_HOST")
-	authToken := os.Getenv("RESOURCELY_AUTH_TOKEN")
+	// Values from other resources are unknown until apply. Rather than
+	// build a client from them, ask Terraform to defer the resources
+	// that use this provider, or skip creating the client so that they
+	// plan without calling the API.
+	if unknown := unknownConfigAttributes(req.Config); len(unknown) > 0 {
+		ctx = tflog.SetField(ctx, "unknown_attributes", unknown)
+		if req.ClientCapabilities.DeferralAllowed {
+			tflog.Info(ctx, "Deferring Resourcely client creation until the provider configuration is known")
+			resp.Deferred = &provider.Deferred{Reason: provider.DeferredReasonProviderConfigUnknown}
+			return
+		}
+		tflog.Warn(ctx, "Skipping Resourcely client creation until the provider configuration is known")
+		return
+	}
+
+	host := os.Getenv(hostnameVar)
 	allowedTenants := make([]string, 0)
+	deniedTenants := make([]string, 0)
 
 	if !config.Host.IsNull() {
 		host = config.Host.ValueString()
 	}
 
-	if !config.AuthToken.IsNull() {
-		authToken = config.AuthToken.ValueString()
+	authToken, authTokenSource, err := resolveAuthToken(ctx, config)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading Resourcely Credentials Failed",
+			err.Error(),
+		)
+		return
+	}
+
+	tokenExpiryWarning := durationSetting(config.TokenExpiryWarning, "token_expiry_warning", tokenExpiryWarningVar, &resp.Diagnostics)
+	if tokenExpiryWarning == 0 {
+		tokenExpiryWarning = defaultTokenExpiryWarning
+	}
+	resp.Diagnostics.Append(validateAuthToken(authToken, time.Now(), tokenExpiryWarning)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	if !config.AllowedTenants.IsNull() {
-		config.AllowedTenants.ElementsAs(ctx, &allowedTenants, false)
+		resp.Diagnostics.Append(config.AllowedTenants.ElementsAs(ctx, &allowedTenants, false)...)
+	}
+
+	if !config.DeniedTenants.IsNull() {
+		resp.Diagnostics.Append(config.DeniedTenants.ElementsAs(ctx, &deniedTenants, false)...)
+	}
+
+	allowedTenantPatterns := tenantPatterns("allowed_tenants", allowedTenants, &resp.Diagnostics)
+	deniedTenantPatterns := tenantPatterns("denied_tenants", deniedTenants, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	if host == "" {
@@ -102,52 +266,85 @@ func (p *ResourcelyProvider) Configure(ctx context.Context, req provider.Configu
 	ctx = tflog.SetField(ctx, "resourcely_host", host)
 	ctx = tflog.SetField(ctx, "resourcely_auth_token", authToken)
 	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "resourcely_auth_token")
+	ctx = tflog.SetField(ctx, "resourcely_auth_token_source", authTokenSource)
 	ctx = tflog.SetField(ctx, "allowed_tenants", allowedTenants)
+	ctx = tflog.SetField(ctx, "denied_tenants", deniedTenants)
+
+	transport, diags := transportConfig(config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	skipHealthCheck := boolSetting(config.SkipHealthCheck, "skip_health_check", skipHealthCheckVar, &resp.Diagnostics)
+	readOnly := boolSetting(config.ReadOnly, "read_only", readOnlyVar, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	tflog.Debug(ctx, "Creating Resourcely client")
 
-	client, err := client.NewClient(nil, host, authToken)
+	httpClient, err := client.NewHTTPClient(transport)
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Creating Resourcley Client Failed",
+			"Configuring Resourcely HTTP Client Failed",
 			err.Error(),
 		)
+		return
 	}
 
-	err = client.Check()
+	c, err := client.NewClient(httpClient, host, authToken)
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Checking API Status Failed",
+			"Creating Resourcley Client Failed",
 			err.Error(),
 		)
+		return
 	}
+	c.ReadOnly = readOnly
+	ctx = tflog.SetField(ctx, "read_only", readOnly)
 
-	if len(allowedTenants) > 0 {
-		tenant, err := client.Tenant()
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Getting Tenant Failed", err.Error(),
-			)
-		}
+	// A token that is not a JWT has no tenant claim. checkTenant only
+	// rejects that if the configuration guards the tenant.
+	tenant, err := c.Tenant()
+	if err != nil {
+		tflog.Debug(ctx, "Could not read the tenant from the auth token", map[string]any{"error": err.Error()})
+	}
 
-		found := false
-		for _, allowedTenant := range allowedTenants {
-			if tenant == allowedTenant {
-				found = true
-				break
-			}
-		}
-		if !found {
-			resp.Diagnostics.AddError(
-				fmt.Sprintf("Resourcely tenant not allowed: %s", tenant),
-				fmt.Sprintf("Allowed tenants are %v", allowedTenants),
+	resp.Diagnostics.Append(checkTenant(tenant, allowedTenantPatterns, deniedTenantPatterns, config.RequireTenantClaim.ValueBool())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if skipHealthCheck {
+		tflog.Debug(ctx, "Skipping Resourcely API health check")
+	} else if err := c.Check(ctx); err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Checking API Status Failed",
+			err.Error(),
+			err,
+		))
+		return
+	}
+
+	// Create the audit log only once the provider is sure to run, so
+	// that a rejected tenant or an unreachable API leaves no file behind.
+	if auditLogPath := stringSetting(config.AuditLogPath, auditLogPathVar); auditLogPath != "" {
+		auditLog, err := client.NewAuditLog(auditLogPath)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("audit_log_path"),
+				"Opening Resourcely Audit Log Failed",
+				err.Error(),
 			)
 			return
 		}
+		c.AuditLog = auditLog
+		ctx = tflog.SetField(ctx, "audit_log_path", auditLogPath)
 	}
 
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	resp.DataSourceData = c
+	resp.ResourceData = c
 
 	tflog.Info(ctx, "Configured Resourcely client", map[string]any{"success": true})
 }
@@ -167,6 +364,11 @@ func (p *ResourcelyProvider) DataSources(ctx context.Context) []func() datasourc
 		NewContextQuestionDataSource,
 		NewGuardrailDataSource,
 		NewGlobalValueDataSource,
+		NewBlueprintsDataSource,
+		NewContextQuestionsDataSource,
+		NewGuardrailsDataSource,
+		NewGlobalValuesDataSource,
+		NewTenantDataSource,
 	}
 }
 
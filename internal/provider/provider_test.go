@@ -1,11 +1,16 @@
 package provider
 
 import (
+	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/fakeapi"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -20,13 +25,28 @@ func testAccPreCheck(t *testing.T) {
 	// You can add code here to run prior to any test case execution, for example assertions
 	// about the appropriate environment variables being set are common to see in a pre-check
 	// function.
+	//
+	// Without credentials for a live tenant, run against an
+	// in-process fake of the Resourcely API instead.
+	if os.Getenv(hostnameVar) == "" && os.Getenv(authTokenVar) == "" {
+		testAccUseFakeAPI(t)
+	}
 	assertEnvVarsAreSet(t)
 }
 
-func assertEnvVarsAreSet(t *testing.T) {
-	const authTokenVar = "RESOURCELY_AUTH_TOKEN"
-	const hostnameVar = "RESOURCELY_HOST"
+// testAccUseFakeAPI starts a fake Resourcely API for the duration of
+// the test and points the provider at it.
+func testAccUseFakeAPI(t *testing.T) *fakeapi.Server {
+	server := fakeapi.NewServer()
+	t.Cleanup(server.Close)
+
+	t.Setenv(hostnameVar, server.URL)
+	t.Setenv(authTokenVar, server.Token)
 
+	return server
+}
+
+func assertEnvVarsAreSet(t *testing.T) {
 	assertVarIsSet := func(varName string) {
 		if os.Getenv(varName) == "" {
 			t.Fatalf("Cannot execute test - required environment var '%s' is empty", varName)
@@ -36,3 +56,46 @@ func assertEnvVarsAreSet(t *testing.T) {
 	assertVarIsSet(authTokenVar)
 	assertVarIsSet(hostnameVar)
 }
+
+func TestAccProvider_skipHealthCheck(t *testing.T) {
+	config := func(skipHealthCheck bool) string {
+		return fmt.Sprintf(`
+provider "resourcely" {
+  host              = "http://127.0.0.1:1"
+  max_retries       = 0
+  skip_health_check = %t
+}
+
+resource "resourcely_global_value" "offline" {
+  key  = "offline"
+  name = "Offline"
+  type = "PRESET_VALUE_TEXT"
+  options = [
+    {
+      key   = "option_0"
+      label = "Option 0"
+      value = jsonencode("option_0_value")
+    },
+  ]
+}
+`, skipHealthCheck)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config(false),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Checking API Status Failed"),
+			},
+			// Planning a create needs no API call once the check is skipped
+			{
+				Config:             config(true),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
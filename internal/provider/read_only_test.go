@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/matoous/go-nanoid/v2"
+)
+
+func TestAccProvider_readOnly(t *testing.T) {
+	id := gonanoid.MustGenerate("abcdefghijklmnopqrstuvwxyz", 16)
+	testAccPreCheck(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Creates are refused
+			{
+				Config:      testAccProviderConfig_readOnly(id, true, "Read Only"),
+				ExpectError: regexp.MustCompile("read-only mode"),
+			},
+			{
+				Config: testAccProviderConfig_readOnly(id, false, "Read Only"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("resourcely_global_value.basic", "name", "Read Only"),
+				),
+			},
+			// Refreshes and data sources still work
+			{
+				Config: testAccProviderConfig_readOnly(id, true, "Read Only") + `
+data "resourcely_global_value" "basic" {
+  key = resourcely_global_value.basic.key
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.resourcely_global_value.basic", "name", "Read Only"),
+				),
+			},
+			// Updates are refused
+			{
+				Config:      testAccProviderConfig_readOnly(id, true, "Read Only Updated"),
+				ExpectError: regexp.MustCompile("read-only mode"),
+			},
+			{
+				Config:   testAccProviderConfig_readOnly(id, false, "Read Only"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccProviderConfig_readOnly(id string, readOnly bool, name string) string {
+	return fmt.Sprintf(`
+provider "resourcely" {
+  host      = %q
+  read_only = %t
+}
+
+resource "resourcely_global_value" "basic" {
+  key  = "read_only_%s"
+  name = %q
+  type = "PRESET_VALUE_TEXT"
+  options = [
+    {
+      key   = "option_0"
+      label = "Option 0"
+      value = jsonencode("option_0_value")
+    },
+  ]
+}
+`, os.Getenv(hostnameVar), readOnly, id, name)
+}
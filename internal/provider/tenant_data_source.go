@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/client"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &TenantDataSource{}
+
+func NewTenantDataSource() datasource.DataSource {
+	return &TenantDataSource{}
+}
+
+// TenantDataSource defines the data source implementation.
+type TenantDataSource struct {
+	client *client.Client
+}
+
+// TenantDataSourceModel describes the data source data model.
+type TenantDataSourceModel struct {
+	Id types.String `tfsdk:"id"`
+
+	Tenant     types.String `tfsdk:"tenant"`
+	Subject    types.String `tfsdk:"subject"`
+	IssuedAt   types.String `tfsdk:"issued_at"`
+	ExpiresAt  types.String `tfsdk:"expires_at"`
+	Host       types.String `tfsdk:"host"`
+	AppVersion types.String `tfsdk:"app_version"`
+}
+
+func (d *TenantDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tenant"
+}
+
+func (d *TenantDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Describes the Resourcely tenant and API that the provider is configured for, as read from the auth token and the API itself. Use it to tag outputs, choose per-tenant defaults, or check in a precondition that a configuration runs against the right tenant.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The tenant name, or `tenant` if the auth token has no tenant claim.",
+				Computed:            true,
+			},
+			"tenant": schema.StringAttribute{
+				MarkdownDescription: "The tenant name from the auth token's `@resourcely/tenant` claim.",
+				Computed:            true,
+			},
+			"subject": schema.StringAttribute{
+				MarkdownDescription: "The user or service account the auth token was issued to, from its `sub` claim.",
+				Computed:            true,
+			},
+			"issued_at": schema.StringAttribute{
+				MarkdownDescription: "When the auth token was issued, as an RFC 3339 timestamp. Null if the token has no `iat` claim.",
+				Computed:            true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "When the auth token expires, as an RFC 3339 timestamp. Null if the token has no `exp` claim.",
+				Computed:            true,
+			},
+			"host": schema.StringAttribute{
+				MarkdownDescription: "The URI of the Resourcely API.",
+				Computed:            true,
+			},
+			"app_version": schema.StringAttribute{
+				MarkdownDescription: "The version of the Resourcely API, as reported by the API.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *TenantDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *TenantDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.Append(unknownConfigDiagnostic())
+		return
+	}
+
+	claims, err := client.ParseClaims(d.client.AuthToken)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading tenant", err.Error())
+		return
+	}
+
+	health, _, err := d.client.System.GetHealth(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostic(
+			"Error reading tenant",
+			"Could not read the Resourcely API version: "+err.Error(),
+			err,
+		))
+		return
+	}
+
+	id := claims.Tenant
+	if id == "" {
+		id = "tenant"
+	}
+
+	state := TenantDataSourceModel{
+		Id:         types.StringValue(id),
+		Tenant:     types.StringValue(claims.Tenant),
+		Subject:    types.StringValue(claims.Subject),
+		IssuedAt:   numericDateValue(claims.IssuedAt),
+		ExpiresAt:  numericDateValue(claims.ExpiresAt),
+		Host:       types.StringValue(d.client.BaseURL.String()),
+		AppVersion: types.StringValue(health.AppVersion),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// numericDateValue formats a JWT time claim as an RFC 3339 timestamp,
+// or null if the claim is missing.
+func numericDateValue(date *jwt.NumericDate) types.String {
+	if date == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(date.UTC().Format(time.RFC3339))
+}
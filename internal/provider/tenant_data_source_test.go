@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTenantDataSource_basic(t *testing.T) {
+	rfc3339 := regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}Z$`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: `data "resourcely_tenant" "current" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.resourcely_tenant.current", "tenant"),
+					resource.TestCheckResourceAttrPair("data.resourcely_tenant.current", "id", "data.resourcely_tenant.current", "tenant"),
+					resource.TestCheckResourceAttrSet("data.resourcely_tenant.current", "subject"),
+					resource.TestMatchResourceAttr("data.resourcely_tenant.current", "issued_at", rfc3339),
+					resource.TestMatchResourceAttr("data.resourcely_tenant.current", "expires_at", rfc3339),
+					resource.TestCheckResourceAttrSet("data.resourcely_tenant.current", "host"),
+					resource.TestCheckResourceAttrSet("data.resourcely_tenant.current", "app_version"),
+				),
+			},
+		},
+	})
+}
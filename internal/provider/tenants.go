@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tfpath "github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// tenantPattern matches tenant names, ignoring case. It is either a
+// glob such as "*-sandbox", or a regular expression between slashes
+// such as "/^acme-(dev|staging)$/".
+type tenantPattern struct {
+	pattern string
+	regex   *regexp.Regexp
+}
+
+func (p tenantPattern) matches(tenant string) bool {
+	if p.regex != nil {
+		return p.regex.MatchString(tenant)
+	}
+	matched, _ := path.Match(strings.ToLower(p.pattern), strings.ToLower(tenant))
+	return matched
+}
+
+// tenantPatterns compiles the patterns of the allowed_tenants or
+// denied_tenants attribute, adding an attribute error for each invalid
+// one.
+func tenantPatterns(attribute string, patterns []string, diags *diag.Diagnostics) []tenantPattern {
+	compiled := make([]tenantPattern, 0, len(patterns))
+	for i, pattern := range patterns {
+		var err error
+		tenant := tenantPattern{pattern: pattern}
+
+		if len(pattern) > 1 && strings.HasPrefix(pattern, "/") && strings.HasSuffix(pattern, "/") {
+			tenant.regex, err = regexp.Compile("(?i)" + pattern[1:len(pattern)-1])
+		} else {
+			_, err = path.Match(pattern, "")
+		}
+
+		if err != nil {
+			diags.AddAttributeError(
+				tfpath.Root(attribute).AtListIndex(i),
+				"Invalid tenant pattern",
+				fmt.Sprintf("%q is not a valid glob or /regular expression/: %s", pattern, err),
+			)
+			continue
+		}
+		compiled = append(compiled, tenant)
+	}
+	return compiled
+}
+
+// checkTenant guards against applying a configuration to the wrong
+// tenant. A tenant matching any denied pattern is rejected, and so is
+// one matching none of the allowed patterns, if there are any. When any
+// guard is configured, the auth token must carry a tenant claim.
+func checkTenant(tenant string, allowed []tenantPattern, denied []tenantPattern, requireClaim bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if len(allowed) == 0 && len(denied) == 0 && !requireClaim {
+		return diags
+	}
+
+	if tenant == "" {
+		diags.AddError(
+			"Resourcely Auth Token Has No Tenant",
+			"The auth token has no @resourcely/tenant claim, so the provider cannot check it against allowed_tenants, denied_tenants or require_tenant_claim.",
+		)
+		return diags
+	}
+
+	for _, pattern := range denied {
+		if pattern.matches(tenant) {
+			diags.AddError(
+				fmt.Sprintf("Resourcely tenant not allowed: %s", tenant),
+				fmt.Sprintf("The tenant matches the denied tenant pattern %q.", pattern.pattern),
+			)
+			return diags
+		}
+	}
+
+	if len(allowed) == 0 {
+		return diags
+	}
+	for _, pattern := range allowed {
+		if pattern.matches(tenant) {
+			return diags
+		}
+	}
+
+	allowedPatterns := make([]string, len(allowed))
+	for i, pattern := range allowed {
+		allowedPatterns[i] = pattern.pattern
+	}
+	diags.AddError(
+		fmt.Sprintf("Resourcely tenant not allowed: %s", tenant),
+		fmt.Sprintf("Allowed tenants are %v", allowedPatterns),
+	)
+	return diags
+}
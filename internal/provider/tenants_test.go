@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/fakeapi"
+)
+
+func TestCheckTenant(t *testing.T) {
+	patterns := func(attribute string, patterns ...string) []tenantPattern {
+		var diags diag.Diagnostics
+		compiled := tenantPatterns(attribute, patterns, &diags)
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		return compiled
+	}
+
+	tests := []struct {
+		name         string
+		tenant       string
+		allowed      []tenantPattern
+		denied       []tenantPattern
+		requireClaim bool
+		allow        bool
+	}{
+		{name: "no guards", tenant: "", allow: true},
+		{name: "exact match ignores case", tenant: "MyCompany", allowed: patterns("allowed_tenants", "mycompany"), allow: true},
+		{name: "not allowed", tenant: "other", allowed: patterns("allowed_tenants", "mycompany"), allow: false},
+		{name: "glob", tenant: "Acme-Sandbox", allowed: patterns("allowed_tenants", "*-sandbox"), allow: true},
+		{name: "regex", tenant: "ACME-STAGING", allowed: patterns("allowed_tenants", "/^acme-(dev|staging)$/"), allow: true},
+		{name: "regex is anchored by the pattern only", tenant: "acme-production", allowed: patterns("allowed_tenants", "/^acme-(dev|staging)$/"), allow: false},
+		{name: "denied", tenant: "acme-prod", denied: patterns("denied_tenants", "*-PROD"), allow: false},
+		{name: "not denied", tenant: "acme-dev", denied: patterns("denied_tenants", "*-prod"), allow: true},
+		{name: "denied takes precedence", tenant: "acme-prod", allowed: patterns("allowed_tenants", "acme-*"), denied: patterns("denied_tenants", "acme-prod"), allow: false},
+		{name: "missing claim with guards", tenant: "", denied: patterns("denied_tenants", "acme-prod"), allow: false},
+		{name: "missing claim required", tenant: "", requireClaim: true, allow: false},
+		{name: "claim required", tenant: "acme", requireClaim: true, allow: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			diags := checkTenant(test.tenant, test.allowed, test.denied, test.requireClaim)
+			if diags.HasError() == test.allow {
+				t.Errorf("expected allowed %t, got %v", test.allow, diags)
+			}
+		})
+	}
+}
+
+func TestTenantPatterns_invalid(t *testing.T) {
+	var diags diag.Diagnostics
+	compiled := tenantPatterns("denied_tenants", []string{"acme-[", "/acme-(/", "acme"}, &diags)
+	if diags.ErrorsCount() != 2 {
+		t.Errorf("expected 2 errors, got %v", diags)
+	}
+	if len(compiled) != 1 {
+		t.Errorf("expected the valid pattern to compile, got %v", compiled)
+	}
+}
+
+func TestAccProvider_tenantGuards(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccUseFakeAPI(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "resourcely" {
+  denied_tenants = ["/^fake-/"]
+}
+
+data "resourcely_global_values" "all" {}
+`,
+				ExpectError: regexp.MustCompile("Resourcely tenant not allowed: fake-tenant"),
+			},
+			{
+				Config: `
+provider "resourcely" {
+  allowed_tenants = ["FAKE-*"]
+  denied_tenants  = ["/-prod$/"]
+}
+
+data "resourcely_global_values" "all" {}
+`,
+			},
+		},
+	})
+}
+
+func TestAccProvider_requireTenantClaim(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			server := testAccUseFakeAPI(t)
+			server.Token = fakeapi.NewToken("", time.Now().Add(time.Hour))
+			t.Setenv(authTokenVar, server.Token)
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "resourcely" {
+  require_tenant_claim = true
+}
+
+data "resourcely_global_values" "all" {}
+`,
+				ExpectError: regexp.MustCompile("Token Has No Tenant"),
+			},
+		},
+	})
+}
@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	sdkresource "go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/client"
+)
+
+// Environment variables that configure tracing. Apart from
+// tracesFileVar, they are the standard OpenTelemetry ones.
+const (
+	sdkDisabledVar        = "OTEL_SDK_DISABLED"
+	tracesExporterVar     = "OTEL_TRACES_EXPORTER"
+	otlpEndpointVar       = "OTEL_EXPORTER_OTLP_ENDPOINT"
+	otlpTracesEndpointVar = "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"
+	traceParentVar        = "TRACEPARENT"
+	traceStateVar         = "TRACESTATE"
+	tracesFileVar         = "RESOURCELY_OTEL_TRACES_FILE"
+)
+
+const (
+	defaultTracingService  = "terraform-provider-resourcely"
+	tracingInstrumentation = client.ProjectURL + "/internal/provider"
+)
+
+// tracingParent is the span of the pipeline that runs Terraform, from
+// the TRACEPARENT environment variable. Terraform does not pass a trace
+// context to providers, so the provider's spans hang off this one.
+var tracingParent trace.SpanContext
+
+// SetupTracing configures OpenTelemetry tracing from the OTEL_*
+// environment variables. Tracing is off unless OTEL_TRACES_EXPORTER or
+// an OTLP endpoint is set:
+//
+//   - "otlp" exports spans over OTLP/HTTP, as configured by the
+//     OTEL_EXPORTER_OTLP_* environment variables.
+//   - "console" writes spans as JSON to RESOURCELY_OTEL_TRACES_FILE, or
+//     to stderr, without any network access.
+//
+// The returned function flushes the remaining spans and must be called
+// before the provider exits.
+func SetupTracing(ctx context.Context, version string) (func(context.Context) error, error) {
+	noop := func(context.Context) error { return nil }
+
+	if disabled, _ := strconv.ParseBool(os.Getenv(sdkDisabledVar)); disabled {
+		return noop, nil
+	}
+
+	exporterName := os.Getenv(tracesExporterVar)
+	if exporterName == "" && (os.Getenv(otlpEndpointVar) != "" || os.Getenv(otlpTracesEndpointVar) != "") {
+		exporterName = "otlp"
+	}
+
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch exporterName {
+	case "", "none":
+		return noop, nil
+	case "otlp":
+		exporter, err = otlptracehttp.New(ctx)
+	case "console":
+		var w io.Writer = os.Stderr
+		if tracesFile := os.Getenv(tracesFileVar); tracesFile != "" {
+			f, openErr := os.OpenFile(tracesFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+			if openErr != nil {
+				return nil, fmt.Errorf("opening %s: %w", tracesFileVar, openErr)
+			}
+			w = f
+		}
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(w))
+	default:
+		return nil, fmt.Errorf("%s must be otlp, console or none, got %q", tracesExporterVar, exporterName)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("creating trace exporter: %w", err)
+	}
+
+	// OTEL_SERVICE_NAME and OTEL_RESOURCE_ATTRIBUTES override the defaults.
+	res, err := sdkresource.New(ctx,
+		sdkresource.WithAttributes(
+			attribute.String("service.name", defaultTracingService),
+			attribute.String("service.version", version),
+		),
+		sdkresource.WithFromEnv(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating trace resource: %w", err)
+	}
+
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(tracerProvider)
+
+	carrier := propagation.MapCarrier{
+		"traceparent": os.Getenv(traceParentVar),
+		"tracestate":  os.Getenv(traceStateVar),
+	}
+	tracingParent = trace.SpanContextFromContext(propagation.TraceContext{}.Extract(ctx, carrier))
+
+	return tracerProvider.Shutdown, nil
+}
+
+// startResourceSpan starts the span for a resource operation, such as
+// "resourcely_blueprint.Update".
+func startResourceSpan(ctx context.Context, resourceType string, operation string) (context.Context, trace.Span) {
+	if !trace.SpanContextFromContext(ctx).IsValid() && tracingParent.IsValid() {
+		ctx = trace.ContextWithRemoteSpanContext(ctx, tracingParent)
+	}
+	return otel.Tracer(tracingInstrumentation).Start(ctx, resourceType+"."+operation,
+		trace.WithAttributes(attribute.String("resourcely.resource_type", resourceType)),
+	)
+}
+
+// endResourceSpan ends the span for a resource operation, recording
+// the series id from state and any error diagnostics. It takes
+// pointers so that it can be deferred before they are set.
+func endResourceSpan(ctx context.Context, span trace.Span, state *tfsdk.State, diags *diag.Diagnostics) {
+	if !state.Raw.IsNull() {
+		var seriesId types.String
+		if d := state.GetAttribute(ctx, path.Root("series_id"), &seriesId); !d.HasError() && !seriesId.IsNull() && !seriesId.IsUnknown() {
+			span.SetAttributes(attribute.String("resourcely.series_id", seriesId.ValueString()))
+		}
+	}
+
+	if diags.HasError() {
+		for _, d := range diags.Errors() {
+			span.RecordError(fmt.Errorf("%s: %s", d.Summary(), d.Detail()))
+		}
+		span.SetStatus(codes.Error, diags.Errors()[0].Summary())
+	}
+	span.End()
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/client"
+	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/fakeapi"
+)
+
+func TestSetupTracing_disabledByDefault(t *testing.T) {
+	t.Setenv(tracesExporterVar, "")
+	t.Setenv(otlpEndpointVar, "")
+	t.Setenv(otlpTracesEndpointVar, "")
+
+	before := otel.GetTracerProvider()
+	shutdown, err := SetupTracing(context.Background(), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := shutdown(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if otel.GetTracerProvider() != before {
+		t.Errorf("expected tracing to stay off without OTEL_* environment variables")
+	}
+}
+
+func TestSetupTracing_invalidExporter(t *testing.T) {
+	t.Setenv(tracesExporterVar, "zipkin")
+
+	if _, err := SetupTracing(context.Background(), "test"); err == nil {
+		t.Errorf("expected an error for an unsupported exporter")
+	}
+}
+
+func TestSetupTracing_console(t *testing.T) {
+	ctx := context.Background()
+	tracesFile := filepath.Join(t.TempDir(), "traces.jsonl")
+	t.Setenv(tracesExporterVar, "console")
+	t.Setenv(tracesFileVar, tracesFile)
+	t.Setenv(traceParentVar, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+
+	previous := otel.GetTracerProvider()
+	t.Cleanup(func() {
+		otel.SetTracerProvider(previous)
+		tracingParent = trace.SpanContext{}
+	})
+
+	shutdown, err := SetupTracing(ctx, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server := fakeapi.NewServer()
+	t.Cleanup(server.Close)
+	c, err := client.NewClient(nil, server.URL, server.Token)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var state tfsdk.State
+	var diags diag.Diagnostics
+	spanCtx, span := startResourceSpan(ctx, "resourcely_global_value", "Read")
+	_, _, _ = c.GlobalValues.GetGlobalValueBySeriesId(spanCtx, "missing")
+	endResourceSpan(spanCtx, span, &state, &diags)
+
+	if err := shutdown(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(tracesFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	traces := string(data)
+	for _, want := range []string{
+		`"Name":"resourcely_global_value.Read"`,
+		`"Name":"GET /api/v1/presets/series/missing"`,
+		`"Value":"missing"`,
+		"resourcely.request_id",
+		"4bf92f3577b34da6a3ce929d0e0e4736",
+	} {
+		if !strings.Contains(traces, want) {
+			t.Errorf("expected %s in the traces, got %s", want, traces)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/client"
+)
+
+// Environment variables for the transport settings. The provider
+// attributes take precedence over them.
+const (
+	requestTimeoutVar = "RESOURCELY_REQUEST_TIMEOUT"
+	maxRetriesVar     = "RESOURCELY_MAX_RETRIES"
+	retryWaitMinVar   = "RESOURCELY_RETRY_WAIT_MIN"
+	retryWaitMaxVar   = "RESOURCELY_RETRY_WAIT_MAX"
+	proxyURLVar       = "RESOURCELY_PROXY_URL"
+	caBundleFileVar   = "RESOURCELY_CA_BUNDLE_FILE"
+	clientCertFileVar = "RESOURCELY_CLIENT_CERT_FILE"
+	clientKeyFileVar  = "RESOURCELY_CLIENT_KEY_FILE"
+)
+
+// transportConfig reads the transport settings from the provider
+// configuration, falling back to their environment variables.
+func transportConfig(config ResourcelyProviderModel) (client.TransportConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	transport := client.TransportConfig{
+		RequestTimeout: durationSetting(config.RequestTimeout, "request_timeout", requestTimeoutVar, &diags),
+		RetryWaitMin:   durationSetting(config.RetryWaitMin, "retry_wait_min", retryWaitMinVar, &diags),
+		RetryWaitMax:   durationSetting(config.RetryWaitMax, "retry_wait_max", retryWaitMaxVar, &diags),
+		ProxyURL:       stringSetting(config.ProxyURL, proxyURLVar),
+		CABundleFile:   stringSetting(config.CABundleFile, caBundleFileVar),
+		ClientCertFile: stringSetting(config.ClientCertFile, clientCertFileVar),
+		ClientKeyFile:  stringSetting(config.ClientKeyFile, clientKeyFileVar),
+	}
+
+	if !config.MaxRetries.IsNull() {
+		maxRetries := int(config.MaxRetries.ValueInt64())
+		transport.MaxRetries = &maxRetries
+	} else if value := os.Getenv(maxRetriesVar); value != "" {
+		maxRetries, err := strconv.Atoi(value)
+		if err != nil || maxRetries < 0 {
+			diags.AddAttributeError(
+				path.Root("max_retries"),
+				"Invalid max_retries",
+				fmt.Sprintf("%s must be a non-negative integer, got %q.", maxRetriesVar, value),
+			)
+		}
+		transport.MaxRetries = &maxRetries
+	}
+
+	return transport, diags
+}
+
+// stringSetting returns the attribute value if it is set, and the
+// environment variable otherwise.
+func stringSetting(value types.String, envVar string) string {
+	if !value.IsNull() {
+		return value.ValueString()
+	}
+	return os.Getenv(envVar)
+}
+
+// boolSetting parses a boolean such as "true" from the attribute or its
+// environment variable. It returns false if neither is set.
+func boolSetting(value types.Bool, attribute string, envVar string, diags *diag.Diagnostics) bool {
+	if !value.IsNull() {
+		return value.ValueBool()
+	}
+
+	setting := os.Getenv(envVar)
+	if setting == "" {
+		return false
+	}
+	b, err := strconv.ParseBool(setting)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root(attribute),
+			"Invalid "+attribute,
+			fmt.Sprintf("%s must be true or false, got %q.", envVar, setting),
+		)
+	}
+	return b
+}
+
+// durationSetting parses a duration such as "30s" from the attribute
+// or its environment variable. It returns zero if neither is set.
+func durationSetting(value types.String, attribute string, envVar string, diags *diag.Diagnostics) time.Duration {
+	setting := stringSetting(value, envVar)
+	if setting == "" {
+		return 0
+	}
+
+	duration, err := time.ParseDuration(setting)
+	if err != nil || duration <= 0 {
+		source := envVar
+		if !value.IsNull() {
+			source = attribute
+		}
+		diags.AddAttributeError(
+			path.Root(attribute),
+			"Invalid "+attribute,
+			fmt.Sprintf("%s must be a positive duration such as \"30s\" or \"2m\", got %q.", source, setting),
+		)
+		return 0
+	}
+	return duration
+}
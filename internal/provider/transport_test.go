@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTransportConfig_environment(t *testing.T) {
+	t.Setenv(requestTimeoutVar, "10s")
+	t.Setenv(maxRetriesVar, "2")
+	t.Setenv(proxyURLVar, "http://proxy.example.com:3128")
+	t.Setenv(caBundleFileVar, "/etc/ssl/env.pem")
+
+	transport, diags := transportConfig(ResourcelyProviderModel{
+		RequestTimeout: types.StringNull(),
+		MaxRetries:     types.Int64Null(),
+		RetryWaitMin:   types.StringNull(),
+		RetryWaitMax:   types.StringValue("5s"),
+		ProxyURL:       types.StringNull(),
+		CABundleFile:   types.StringValue("/etc/ssl/config.pem"),
+		ClientCertFile: types.StringNull(),
+		ClientKeyFile:  types.StringNull(),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if transport.RequestTimeout != 10*time.Second {
+		t.Errorf("expected the request timeout from the environment, got %s", transport.RequestTimeout)
+	}
+	if transport.MaxRetries == nil || *transport.MaxRetries != 2 {
+		t.Errorf("expected max retries from the environment, got %v", transport.MaxRetries)
+	}
+	if transport.RetryWaitMin != 0 || transport.RetryWaitMax != 5*time.Second {
+		t.Errorf("expected the default retry wait min and the configured max, got %s and %s", transport.RetryWaitMin, transport.RetryWaitMax)
+	}
+	if transport.ProxyURL != "http://proxy.example.com:3128" {
+		t.Errorf("expected the proxy URL from the environment, got %q", transport.ProxyURL)
+	}
+	if transport.CABundleFile != "/etc/ssl/config.pem" {
+		t.Errorf("expected the configured CA bundle to take precedence, got %q", transport.CABundleFile)
+	}
+}
+
+func TestTransportConfig_invalid(t *testing.T) {
+	t.Setenv(maxRetriesVar, "many")
+
+	_, diags := transportConfig(ResourcelyProviderModel{
+		RequestTimeout: types.StringValue("30"),
+		MaxRetries:     types.Int64Null(),
+		RetryWaitMin:   types.StringNull(),
+		RetryWaitMax:   types.StringNull(),
+		ProxyURL:       types.StringNull(),
+		CABundleFile:   types.StringNull(),
+		ClientCertFile: types.StringNull(),
+		ClientKeyFile:  types.StringNull(),
+	})
+	if diags.ErrorsCount() != 2 {
+		t.Fatalf("expected errors for request_timeout and max_retries, got %v", diags)
+	}
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// unknownConfigAttributes returns the provider attributes whose values
+// are not known until apply, for example a host that comes from the
+// output of another resource.
+func unknownConfigAttributes(config tfsdk.Config) []string {
+	var attributes map[string]tftypes.Value
+	if err := config.Raw.As(&attributes); err != nil {
+		return nil
+	}
+
+	var unknown []string
+	for name, value := range attributes {
+		if !value.IsFullyKnown() {
+			unknown = append(unknown, name)
+		}
+	}
+	sort.Strings(unknown)
+	return unknown
+}
+
+// unknownConfigDiagnostic is the error for a data source that is read
+// while the provider configuration is unknown. Data sources cannot
+// return unknown values, so they cannot be read until the provider is
+// configured.
+func unknownConfigDiagnostic() diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Unknown Resourcely provider configuration",
+		"The provider configuration depends on values that are not known until apply, so this data source cannot be read yet. "+
+			"Apply the resources the provider configuration depends on first, for example with -target.",
+	)
+}
+
+// awaitingConfig reports whether a resource has no client yet, because
+// Configure skipped creating it while the provider configuration was
+// unknown. Read then keeps the prior state until it can be refreshed.
+// Create, Update and Delete only run once the configuration is known.
+func awaitingConfig[S any](service *S) bool {
+	return service == nil
+}
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/matoous/go-nanoid/v2"
+)
+
+func TestAccProvider_unknownHost(t *testing.T) {
+	// The live API's tests also run Terraform versions before 1.4,
+	// which have no terraform_data.
+	if os.Getenv(hostnameVar) != "" || os.Getenv(authTokenVar) != "" {
+		t.Skip("Making the host unknown needs Terraform 1.4 and the fake Resourcely API")
+	}
+	testAccUseFakeAPI(t)
+	id := gonanoid.MustGenerate("abcdefghijklmnopqrstuvwxyz", 16)
+	// The configs embed the host, so it must be set before they are built.
+	testAccPreCheck(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The host is unknown while planning the create
+			{
+				Config: testAccProviderConfig_unknownHost(id, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("resourcely_global_value.basic", "key", "unknown_host_"+id),
+				),
+			},
+			// The host is unknown again while refreshing the global value
+			{
+				Config: testAccProviderConfig_unknownHost(id, "/"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("resourcely_global_value.basic", "key", "unknown_host_"+id),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProvider_unknownHostDataSource(t *testing.T) {
+	// The live API's tests also run Terraform versions before 1.4,
+	// which have no terraform_data.
+	if os.Getenv(hostnameVar) != "" || os.Getenv(authTokenVar) != "" {
+		t.Skip("Making the host unknown needs Terraform 1.4 and the fake Resourcely API")
+	}
+	testAccUseFakeAPI(t)
+	testAccPreCheck(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "terraform_data" "host" {
+  input = %q
+}
+
+provider "resourcely" {
+  host = terraform_data.host.output
+}
+
+data "resourcely_tenant" "current" {}
+`, os.Getenv(hostnameVar)),
+				ExpectError: regexp.MustCompile("Unknown Resourcely provider configuration"),
+			},
+		},
+	})
+}
+
+// testAccProviderConfig_unknownHost configures the provider with a host
+// from a terraform_data resource, which is unknown whenever the
+// resource is replaced. Changing suffix replaces it.
+func testAccProviderConfig_unknownHost(id string, suffix string) string {
+	return fmt.Sprintf(`
+resource "terraform_data" "host" {
+  input = "%s%s"
+}
+
+provider "resourcely" {
+  host = terraform_data.host.output
+}
+
+resource "resourcely_global_value" "basic" {
+  key  = "unknown_host_%s"
+  name = "Unknown Host %s"
+  type = "PRESET_VALUE_TEXT"
+  options = [
+    {
+      key   = "option_0"
+      label = "Option 0"
+      value = jsonencode("option_0_value")
+    },
+  ]
+}
+`, os.Getenv(hostnameVar), suffix, id, id)
+}
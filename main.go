@@ -7,6 +7,7 @@ import (
 	"context"
 	"flag"
 	"log"
+	"time"
 
 	"github.com/Resourcely-Inc/terraform-provider-resourcely/internal/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
@@ -42,7 +43,24 @@ func main() {
 		Debug:   debug,
 	}
 
-	err := providerserver.Serve(context.Background(), provider.New(version), opts)
+	ctx := context.Background()
+
+	// Tracing is opt-in through the OTEL_* environment variables. A
+	// broken tracing setup must not stop Terraform from running.
+	shutdownTracing, err := provider.SetupTracing(ctx, version)
+	if err != nil {
+		log.Printf("[WARN] running without tracing: %s", err)
+		shutdownTracing = func(context.Context) error { return nil }
+	}
+
+	err = providerserver.Serve(ctx, provider.New(version), opts)
+
+	// Flush the spans before exiting, but don't hold up Terraform.
+	shutdownCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	if shutdownErr := shutdownTracing(shutdownCtx); shutdownErr != nil {
+		log.Printf("flushing traces: %s", shutdownErr)
+	}
 
 	if err != nil {
 		log.Fatal(err.Error())